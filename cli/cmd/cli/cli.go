@@ -3,12 +3,14 @@ package main
 import (
 	"context"
 	"github.com/harryrose/godm/cli/commands"
+	"github.com/harryrose/godm/log/tracing"
 	"github.com/urfave/cli/v3"
 	"log"
 	"os"
 )
 
 func main() {
+	finishTracing := func(error) {}
 	app := &cli.Command{
 		Name:  "godm",
 		Usage: "manage a GoDM queue",
@@ -19,13 +21,109 @@ func main() {
 				Required: true,
 			},
 			&cli.StringFlag{
-				Name:     commands.ArgKey,
-				Aliases:  []string{"k"},
-				Required: true,
+				Name:    commands.ArgKey,
+				Aliases: []string{"k"},
+				Usage:   "The API key to use. Not needed if a client certificate is given",
+			},
+			&cli.BoolFlag{
+				Name:  commands.ArgTLS,
+				Usage: "Connect with TLS, verifying the queue host's certificate against the system's CAs unless --" + commands.ArgTLSCA + " is given. Implied by the other TLS flags",
+			},
+			&cli.StringFlag{
+				Name:  commands.ArgTLSCA,
+				Usage: "The path to PEM CA certificates to verify the queue host's certificate with",
+			},
+			&cli.StringFlag{
+				Name:  commands.ArgTLSCert,
+				Usage: "The path to a PEM client certificate to present to the queue host",
+			},
+			&cli.StringFlag{
+				Name:  commands.ArgTLSKey,
+				Usage: "The path to the PEM private key of the client certificate",
+			},
+			&cli.StringFlag{
+				Name:  commands.ArgTraceExporter,
+				Usage: "Where to send traces of the command's calls: none, stdout (written to stderr) or otlp",
+				Value: tracing.ExporterNone,
+			},
+			&cli.StringFlag{
+				Name:        commands.ArgTraceEndpoint,
+				Usage:       "The address or URL of the OTLP collector to send traces to",
+				DefaultText: "localhost:4317",
 			},
 		},
+		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+			ctx, finish, err := commands.StartTracing(ctx, cmd.String(commands.ArgTraceExporter), cmd.String(commands.ArgTraceEndpoint))
+			if err != nil {
+				return ctx, err
+			}
+			finishTracing = finish
+			return ctx, nil
+		},
+		After: func(ctx context.Context, cmd *cli.Command) error {
+			finishTracing(nil)
+			return nil
+		},
+		// exit errors end the process before After is run
+		ExitErrHandler: func(ctx context.Context, cmd *cli.Command, err error) {
+			finishTracing(err)
+			cli.HandleExitCoder(err)
+		},
 		Commands: []*cli.Command{
 			commands.Add(),
+			commands.Move(),
+			commands.Requeue(),
+			{
+				Name:  "create",
+				Usage: "Create an object",
+				Commands: []*cli.Command{
+					commands.CreateKey(),
+					commands.CreateCategory(),
+				},
+			},
+			{
+				Name:  "rotate",
+				Usage: "Replace an object's secret",
+				Commands: []*cli.Command{
+					commands.RotateKey(),
+				},
+			},
+			{
+				Name:  "revoke",
+				Usage: "Revoke an object",
+				Commands: []*cli.Command{
+					commands.RevokeKey(),
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Delete an object",
+				Commands: []*cli.Command{
+					commands.DeleteQueue(),
+					commands.DeleteCategory(),
+				},
+			},
+			{
+				Name:  "rename",
+				Usage: "Rename an object",
+				Commands: []*cli.Command{
+					commands.RenameQueue(),
+				},
+			},
+			{
+				Name:  "pause",
+				Usage: "Pause an object",
+				Commands: []*cli.Command{
+					commands.PauseQueue(),
+				},
+			},
+			{
+				Name:  "resume",
+				Usage: "Resume a paused object",
+				Commands: []*cli.Command{
+					commands.ResumeQueue(),
+				},
+			},
 			{
 				Name:  "clear",
 				Usage: "Remove all items from an object",
@@ -33,12 +131,26 @@ func main() {
 					commands.ClearHistory(),
 				},
 			},
+			{
+				Name:  "set",
+				Usage: "Change the settings of an object",
+				Commands: []*cli.Command{
+					commands.SetRetryPolicy(),
+					commands.SetConfig(),
+					commands.SetItem(),
+					commands.SetCategory(),
+				},
+			},
 			{
 				Name: "show",
 				Commands: []*cli.Command{
 					commands.ShowQueue(),
 					commands.ShowHistory(),
 					commands.ShowQueues(),
+					commands.ShowConfig(),
+					commands.ShowWorkers(),
+					commands.ShowKeys(),
+					commands.ShowCategories(),
 				},
 			},
 		},
@@ -5,23 +5,47 @@ import (
 	"fmt"
 	"github.com/harryrose/godm/cli/queue"
 	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 )
 
 const (
 	ArgSourceURL       = "source_url"
 	ArgDestinationPath = "destination_path"
+	FlagStart          = "start"
+	FlagEvery          = "every"
+	FlagDependsOn      = "depends-on"
+	FlagHoldOnFailure  = "hold-on-failure"
+	FlagDuplicates     = "duplicates"
+	FlagMatch          = "match"
+	FlagCheckHistory   = "check-history"
 )
 
+var duplicatePolicies = map[string]queue.EnqueueItemInput_DuplicatePolicy{
+	"allow":           queue.EnqueueItemInput_DUPLICATE_POLICY_ALLOW,
+	"reject":          queue.EnqueueItemInput_DUPLICATE_POLICY_REJECT,
+	"return-existing": queue.EnqueueItemInput_DUPLICATE_POLICY_RETURN_EXISTING,
+}
+
+var duplicateMatches = map[string]queue.EnqueueItemInput_DuplicateMatch{
+	"source":      queue.EnqueueItemInput_DUPLICATE_MATCH_SOURCE,
+	"destination": queue.EnqueueItemInput_DUPLICATE_MATCH_DESTINATION,
+	"both":        queue.EnqueueItemInput_DUPLICATE_MATCH_SOURCE_AND_DESTINATION,
+}
+
 func Add() *cli.Command {
 	return &cli.Command{
 		Name:      "add",
 		Usage:     "Queue an item for download",
 		ArgsUsage: "<source_url> [destination_path]",
 		Action:    add,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:        FlagQueue,
 				DefaultText: DefQueue,
@@ -30,10 +54,46 @@ func Add() *cli.Command {
 			&cli.StringFlag{
 				Name:        FlagCategory,
 				Aliases:     []string{"cat"},
-				DefaultText: DefCategory,
-				Value:       DefCategory,
+				Usage:       "The item's category",
+				DefaultText: "the queue's default category",
 			},
-		},
+			&cli.IntFlag{
+				Name:    FlagPriority,
+				Aliases: []string{"p"},
+				Usage:   "Items with a higher priority are downloaded first",
+			},
+			&cli.StringFlag{
+				Name:  FlagStart,
+				Usage: "Don't download the item before the given time. Either a date, an RFC3339 time, or a duration after now such as 2h",
+			},
+			&cli.StringFlag{
+				Name:  FlagEvery,
+				Usage: "Download the item repeatedly on the given cron schedule, such as \"0 2 * * *\" or @daily. Evaluated in UTC unless prefixed with CRON_TZ=<zone>",
+			},
+			&cli.StringSliceFlag{
+				Name:    FlagDependsOn,
+				Aliases: []string{"after"},
+				Usage:   "The id of an item in the same queue which must complete before this one is downloaded. May be repeated",
+			},
+			&cli.BoolFlag{
+				Name:  FlagHoldOnFailure,
+				Usage: "If an item this depends on fails, keep this item in the queue rather than failing it",
+			},
+			&cli.StringFlag{
+				Name:  FlagDuplicates,
+				Usage: "What to do if the item duplicates one already queued: allow it, reject it, or return-existing to print the existing item's id instead",
+				Value: "allow",
+			},
+			&cli.StringFlag{
+				Name:  FlagMatch,
+				Usage: "Which items the item duplicates: those with the same source, destination, or both",
+				Value: "source",
+			},
+			&cli.BoolFlag{
+				Name:  FlagCheckHistory,
+				Usage: "Also treat the item as a duplicate of items which have completed",
+			},
+		}, retryFlags()...),
 		Arguments: []cli.Argument{
 			&cli.StringArg{
 				Name:      ArgSourceURL,
@@ -83,21 +143,87 @@ func add(ctx context.Context, cmd *cli.Command) error {
 		return cli.Exit("only file:// destination urls are supported", CodeInvalidArgument)
 	}
 
+	retryPolicy, err := retryPolicyFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	start, err := parseFutureTimeFlag(cmd, FlagStart)
+	if err != nil {
+		return err
+	}
+
 	client, err := getRPCClient(cmd)
 	if err != nil {
 		return err
 	}
-	_, err = client.EnqueueItem(ctx, &queue.EnqueueItemInput{
+	dependencyPolicy := queue.EnqueueItemInput_DEPENDENCY_POLICY_FAIL
+	if cmd.Bool(FlagHoldOnFailure) {
+		dependencyPolicy = queue.EnqueueItemInput_DEPENDENCY_POLICY_HOLD
+	}
+	var category *queue.Category
+	if cmd.IsSet(FlagCategory) {
+		// otherwise the queue's default category applies
+		category = &queue.Category{Id: &queue.Identifier{Id: cmd.String(FlagCategory)}}
+	}
+	var dependsOn []*queue.Identifier
+	for _, id := range cmd.StringSlice(FlagDependsOn) {
+		dependsOn = append(dependsOn, &queue.Identifier{Id: id})
+	}
+	duplicatePolicy, ok := duplicatePolicies[strings.ToLower(cmd.String(FlagDuplicates))]
+	if !ok {
+		return cli.Exit(fmt.Sprintf("unrecognised duplicate policy %q", cmd.String(FlagDuplicates)), CodeInvalidArgument)
+	}
+	duplicateMatch, ok := duplicateMatches[strings.ToLower(cmd.String(FlagMatch))]
+	if !ok {
+		return cli.Exit(fmt.Sprintf("unrecognised duplicate match %q", cmd.String(FlagMatch)), CodeInvalidArgument)
+	}
+
+	res, err := client.EnqueueItem(ctx, &queue.EnqueueItemInput{
 		Queue: &queue.Identifier{Id: cmd.String(FlagQueue)},
 		Item: &queue.Item{
 			Source:      &queue.Target{Url: srcUrl.String()},
 			Destination: &queue.Target{Url: dstUrl.String()},
-			Category:    &queue.Category{Id: &queue.Identifier{Id: cmd.String(FlagCategory)}},
+			Category:    category,
+			Priority:    int32(cmd.Int(FlagPriority)),
+			RetryPolicy: retryPolicy,
+			NotBefore:   start,
+			Recurrence:  cmd.String(FlagEvery),
 		},
+		DependsOn:        dependsOn,
+		DependencyPolicy: dependencyPolicy,
+		DuplicatePolicy:  duplicatePolicy,
+		DuplicateMatch:   duplicateMatch,
+		DuplicateHistory: cmd.Bool(FlagCheckHistory),
 	})
+	if existing := duplicateOf(err); existing != "" {
+		return cli.Exit(fmt.Sprintf("the item duplicates item %v", existing), CodeDuplicate)
+	}
 	if err != nil {
 		return cli.Exit(fmt.Sprintf("error adding the item to the queue: %v", err), CodeInternalError)
 	}
-	fmt.Fprintln(os.Stderr, "item added")
+	if res.Duplicate {
+		fmt.Fprintln(os.Stderr, "item already added")
+	} else {
+		fmt.Fprintln(os.Stderr, "item added")
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fmt.Fprintln(os.Stderr, "trace", sc.TraceID())
+	}
+	fmt.Fprintln(os.Stdout, res.Id.Id)
 	return nil
 }
+
+// duplicateOf returns the id of the existing item named by an error which EnqueueItem returned
+// because the item was a duplicate, or an empty string if err wasn't such an error.
+func duplicateOf(err error) string {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.AlreadyExists {
+		return ""
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ResourceInfo); ok && info.ResourceType == "item" {
+			return info.ResourceName
+		}
+	}
+	return ""
+}
@@ -1,13 +1,22 @@
 package commands
 
 const (
-	FlagCategory = "category"
-	FlagQueue    = "queue"
-	ArgQueueHost = "queue-host"
-	ArgKey       = "key"
+	FlagCategory     = "category"
+	FlagPriority     = "priority"
+	FlagQueue        = "queue"
+	FlagWatch        = "watch"
+	ArgQueueHost     = "queue-host"
+	ArgKey           = "key"
+	ArgTLS           = "tls"
+	ArgTLSCA         = "tls-ca"
+	ArgTLSCert       = "tls-cert"
+	ArgTLSKey        = "tls-key"
+	ArgTraceExporter = "trace-exporter"
+	ArgTraceEndpoint = "trace-endpoint"
+	ArgItemID        = "item_id"
+	ArgOtherID       = "other_item_id"
 )
 
 const (
-	DefCategory = "default"
-	DefQueue    = "default"
+	DefQueue = "default"
 )
@@ -0,0 +1,240 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	FlagDirectory   = "dir"
+	FlagRateLimit   = "rate-limit"
+	FlagAllowedHost = "allowed-host"
+	FlagAnyHost     = "any-host"
+	ArgCategoryName = "name"
+)
+
+// categoryFields maps the flags of the set category command to the fields of the category which
+// they update.
+var categoryFields = map[string]string{
+	FlagDirectory:         "destination_template",
+	FlagRateLimit:         "rate_limit_bytes_per_second",
+	FlagAllowedHost:       "allowed_hosts",
+	FlagAnyHost:           "allowed_hosts",
+	FlagMaxAttempts:       "retry_policy",
+	FlagBackoff:           "retry_policy",
+	FlagMaxBackoff:        "retry_policy",
+	FlagBackoffMultiplier: "retry_policy",
+	FlagRetryOn:           "retry_policy",
+}
+
+func categoryNameArg() cli.Argument {
+	return &cli.StringArg{
+		Name:      ArgCategoryName,
+		UsageText: "The name of the category, as given to its items",
+	}
+}
+
+// categoryFlags returns the flags used to describe a category's settings.
+func categoryFlags() []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagDirectory,
+			Usage: "The directory, relative to the downloader's, under which the category's items with a relative destination are saved. May contain {category}, {host} and {date}",
+		},
+		&cli.UintFlag{
+			Name:  FlagRateLimit,
+			Usage: "The download rate in bytes per second of the category's items, in place of their queue's (0 to leave it to the queue)",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagAllowedHost,
+			Usage: "A host the category's items may be downloaded from. Prefix with *. to allow its subdomains too. May be repeated (default: any host)",
+		},
+	}, retryFlags()...)
+}
+
+func CreateCategory() *cli.Command {
+	return &cli.Command{
+		Name:      "category",
+		Usage:     "Create a category, whose settings apply to its items in every queue",
+		ArgsUsage: "<name>",
+		Action:    createCategory,
+		Arguments: []cli.Argument{categoryNameArg()},
+		Flags:     categoryFlags(),
+	}
+}
+
+func SetCategory() *cli.Command {
+	return &cli.Command{
+		Name:      "category",
+		Usage:     "Change a category's settings. Only the settings given are changed, though the retry flags replace the whole retry policy",
+		ArgsUsage: "<name>",
+		Action:    setCategory,
+		Arguments: []cli.Argument{categoryNameArg()},
+		Flags: append(categoryFlags(), &cli.BoolFlag{
+			Name:  FlagAnyHost,
+			Usage: "Allow the category's items to be downloaded from any host",
+		}),
+	}
+}
+
+func DeleteCategory() *cli.Command {
+	return &cli.Command{
+		Name:      "category",
+		Usage:     "Delete a category. Its items keep the category, without its settings",
+		ArgsUsage: "<name>",
+		Action:    deleteCategory,
+		Arguments: []cli.Argument{categoryNameArg()},
+	}
+}
+
+func ShowCategories() *cli.Command {
+	return &cli.Command{
+		Name:   "categories",
+		Usage:  "Show the categories and their settings",
+		Action: showCategories,
+	}
+}
+
+// categoryFromFlags builds the named category's settings from the command's flags.
+func categoryFromFlags(cmd *cli.Command, name string) (*queue.CategoryConfig, error) {
+	policy, err := retryPolicyFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &queue.CategoryConfig{
+		Id:                      &queue.Identifier{Id: name},
+		DestinationTemplate:     cmd.String(FlagDirectory),
+		RateLimitBytesPerSecond: uint64(cmd.Uint(FlagRateLimit)),
+		RetryPolicy:             policy,
+		AllowedHosts:            cmd.StringSlice(FlagAllowedHost),
+	}, nil
+}
+
+func createCategory(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgCategoryName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	category, err := categoryFromFlags(cmd, name)
+	if err != nil {
+		return err
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.CreateCategory(ctx, &queue.CreateCategoryInput{Category: category})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error creating category: %v", err), CodeInternalError)
+	}
+	printCategory(res.Category)
+	return nil
+}
+
+func setCategory(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgCategoryName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	if cmd.IsSet(FlagAllowedHost) && cmd.Bool(FlagAnyHost) {
+		return cli.Exit("give either --allowed-host or --any-host, not both", CodeInvalidArgument)
+	}
+	mask := &fieldmaskpb.FieldMask{}
+	for flag, field := range categoryFields {
+		if cmd.IsSet(flag) && !slices.Contains(mask.Paths, field) {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	if len(mask.Paths) == 0 {
+		return cli.Exit("at least one setting must be given", CodeInvalidArgument)
+	}
+	category, err := categoryFromFlags(cmd, name)
+	if err != nil {
+		return err
+	}
+
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.UpdateCategory(ctx, &queue.UpdateCategoryInput{
+		Category:   category,
+		UpdateMask: mask,
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error changing the category: %v", err), CodeInternalError)
+	}
+	printCategory(res.Category)
+	return nil
+}
+
+func deleteCategory(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgCategoryName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteCategory(ctx, &queue.DeleteCategoryInput{Category: &queue.Identifier{Id: name}})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error deleting category: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func showCategories(ctx context.Context, cmd *cli.Command) error {
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.ListCategories(ctx, &queue.ListCategoriesInput{})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error fetching categories: %v", err), CodeInternalError)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "Name\tDirectory\tRate limit\tAllowed hosts\tRetry policy\n")
+	for _, category := range res.Categories {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			category.Id.Id,
+			category.DestinationTemplate,
+			zeroAs(category.RateLimitBytesPerSecond, "queue's"),
+			allowedHostsToString(category.AllowedHosts),
+			categoryRetryPolicyToString(category.RetryPolicy))
+	}
+	return nil
+}
+
+func printCategory(category *queue.CategoryConfig) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "Name\t%s\n", category.Id.Id)
+	fmt.Fprintf(w, "Directory\t%s\n", category.DestinationTemplate)
+	fmt.Fprintf(w, "Rate limit\t%s\n", zeroAs(category.RateLimitBytesPerSecond, "queue's"))
+	fmt.Fprintf(w, "Allowed hosts\t%s\n", allowedHostsToString(category.AllowedHosts))
+	fmt.Fprintf(w, "Retry policy\t%s\n", categoryRetryPolicyToString(category.RetryPolicy))
+}
+
+func allowedHostsToString(hosts []string) string {
+	if len(hosts) == 0 {
+		return "any"
+	}
+	return strings.Join(hosts, ", ")
+}
+
+func categoryRetryPolicyToString(policy *queue.RetryPolicy) string {
+	if policy == nil {
+		return "queue's"
+	}
+	return retryPolicyToString(policy)
+}
@@ -10,29 +10,39 @@ import (
 func ClearHistory() *cli.Command {
 	return &cli.Command{
 		Name:   "history",
-		Usage:  "Clear a queue's finished items",
+		Usage:  "Clear a queue's finished items, or only those which match the filter flags",
 		Action: clearHistory,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:  FlagQueue,
 				Value: DefQueue,
 			},
-		},
+		}, matchFlags("clear")...),
 	}
 }
 
 func clearHistory(ctx context.Context, cmd *cli.Command) error {
+	input := &queue.ClearHistoryInput{
+		Queue: &queue.Identifier{
+			Id: cmd.String(FlagQueue),
+		},
+	}
+	if matchFlagsSet(cmd) {
+		filter, err := matchFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		input.Filter = filter
+	}
+
 	client, err := getRPCClient(cmd)
 	if err != nil {
 		return err
 	}
-	_, err = client.ClearHistory(ctx, &queue.ClearHistoryInput{
-		Queue: &queue.Identifier{
-			Id: cmd.String(FlagQueue),
-		},
-	})
+	res, err := client.ClearHistory(ctx, input)
 	if err != nil {
 		return cli.Exit(fmt.Sprintf("error clearing history: %v", err), CodeInternalError)
 	}
+	fmt.Printf("%d items removed\n", res.Removed)
 	return nil
 }
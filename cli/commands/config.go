@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	FlagClaimTTL                 = "claim-ttl"
+	FlagMaxClaims                = "max-claims"
+	FlagDefaultCategory          = "default-category"
+	FlagDefaultDestinationPrefix = "default-destination-prefix"
+	FlagDefaultRateLimit         = "default-rate-limit"
+	FlagKeepEntries              = "keep-entries"
+	FlagKeepFor                  = "keep-for"
+	FlagKeepFailedFor            = "keep-failed-for"
+)
+
+// configFields maps the flags of the set config command to the fields of the queue config which
+// they update.  The history retention flags each change part of the one field.
+var configFields = map[string]string{
+	FlagClaimTTL:                 "claim_ttl",
+	FlagMaxClaims:                "max_concurrent_claims",
+	FlagDefaultCategory:          "default_category",
+	FlagDefaultDestinationPrefix: "default_destination_prefix",
+	FlagDefaultRateLimit:         "default_rate_limit_bytes_per_second",
+	FlagKeepEntries:              "history_retention",
+	FlagKeepFor:                  "history_retention",
+	FlagKeepFailedFor:            "history_retention",
+}
+
+func ShowConfig() *cli.Command {
+	return &cli.Command{
+		Name:   "config",
+		Usage:  "Show a queue's settings",
+		Action: showConfig,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagQueue,
+				Value: DefQueue,
+			},
+		},
+	}
+}
+
+func showConfig(ctx context.Context, cmd *cli.Command) error {
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.GetQueueConfig(ctx, &queue.GetQueueConfigInput{
+		Queue: &queue.Identifier{Id: cmd.String(FlagQueue)},
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error fetching the queue's settings: %v", err), CodeInternalError)
+	}
+	printConfig(res.Config)
+	return nil
+}
+
+func SetConfig() *cli.Command {
+	return &cli.Command{
+		Name:   "config",
+		Usage:  "Change a queue's settings. Only the settings given are changed",
+		Action: setConfig,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagQueue,
+				Value: DefQueue,
+			},
+			&cli.DurationFlag{
+				Name:  FlagClaimTTL,
+				Usage: "How long a claim on an item lasts without a progress report, at least 10s (0 for the default of 30s)",
+			},
+			&cli.UintFlag{
+				Name:  FlagMaxClaims,
+				Usage: "The maximum number of items which can be downloading at once (0 for unlimited)",
+			},
+			&cli.StringFlag{
+				Name:  FlagDefaultCategory,
+				Usage: "The category given to items added without one",
+			},
+			&cli.StringFlag{
+				Name:  FlagDefaultDestinationPrefix,
+				Usage: "The directory under which items with a relative destination are saved",
+			},
+			&cli.UintFlag{
+				Name:  FlagDefaultRateLimit,
+				Usage: "The download rate in bytes per second which downloaders use for the queue's items (0 to leave it to the downloader)",
+			},
+			&cli.UintFlag{
+				Name:  FlagKeepEntries,
+				Usage: "The most completed and cancelled items kept in the queue's history (0 for unlimited). Failed items are only removed by age",
+			},
+			&cli.DurationFlag{
+				Name:  FlagKeepFor,
+				Usage: "How long finished items are kept in the queue's history (0 for ever)",
+			},
+			&cli.DurationFlag{
+				Name:  FlagKeepFailedFor,
+				Usage: "How long failed items are kept in the queue's history, if longer than --keep-for (0 to use --keep-for)",
+			},
+		},
+	}
+}
+
+func setConfig(ctx context.Context, cmd *cli.Command) error {
+	mask := &fieldmaskpb.FieldMask{}
+	for flag, field := range configFields {
+		if cmd.IsSet(flag) && !slices.Contains(mask.Paths, field) {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	if len(mask.Paths) == 0 {
+		return cli.Exit("at least one setting must be given", CodeInvalidArgument)
+	}
+
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	var retention *queue.HistoryRetention
+	if slices.Contains(mask.Paths, "history_retention") {
+		if retention, err = historyRetentionFromFlags(ctx, cmd, client); err != nil {
+			return err
+		}
+	}
+	res, err := client.UpdateQueueConfig(ctx, &queue.UpdateQueueConfigInput{
+		Queue: &queue.Identifier{Id: cmd.String(FlagQueue)},
+		Config: &queue.QueueConfig{
+			ClaimTtl:                       durationpb.New(cmd.Duration(FlagClaimTTL)),
+			MaxConcurrentClaims:            uint32(cmd.Uint(FlagMaxClaims)),
+			DefaultCategory:                cmd.String(FlagDefaultCategory),
+			DefaultDestinationPrefix:       cmd.String(FlagDefaultDestinationPrefix),
+			DefaultRateLimitBytesPerSecond: uint64(cmd.Uint(FlagDefaultRateLimit)),
+			HistoryRetention:               retention,
+		},
+		UpdateMask: mask,
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error changing the queue's settings: %v", err), CodeInternalError)
+	}
+	printConfig(res.Config)
+	return nil
+}
+
+// historyRetentionFromFlags returns the queue's history retention with the parts given by the
+// command's flags changed.  The retention is updated as a whole, so the parts which weren't given
+// are fetched from the queue.
+func historyRetentionFromFlags(ctx context.Context, cmd *cli.Command, client queue.QueueServiceClient) (*queue.HistoryRetention, error) {
+	res, err := client.GetQueueConfig(ctx, &queue.GetQueueConfigInput{
+		Queue: &queue.Identifier{Id: cmd.String(FlagQueue)},
+	})
+	if err != nil {
+		return nil, cli.Exit(fmt.Sprintf("error fetching the queue's settings: %v", err), CodeInternalError)
+	}
+	retention := res.Config.GetHistoryRetention()
+	if retention == nil {
+		retention = &queue.HistoryRetention{}
+	}
+	if cmd.IsSet(FlagKeepEntries) {
+		retention.MaxEntries = uint32(cmd.Uint(FlagKeepEntries))
+	}
+	if cmd.IsSet(FlagKeepFor) {
+		retention.MaxAge = durationpb.New(cmd.Duration(FlagKeepFor))
+	}
+	if cmd.IsSet(FlagKeepFailedFor) {
+		retention.FailedMaxAge = durationpb.New(cmd.Duration(FlagKeepFailedFor))
+	}
+	return retention, nil
+}
+
+func printConfig(config *queue.QueueConfig) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "Claim TTL\t%v\n", config.ClaimTtl.AsDuration())
+	fmt.Fprintf(w, "Max claims\t%s\n", zeroAs(config.MaxConcurrentClaims, "unlimited"))
+	fmt.Fprintf(w, "Default category\t%s\n", config.DefaultCategory)
+	fmt.Fprintf(w, "Default destination prefix\t%s\n", config.DefaultDestinationPrefix)
+	fmt.Fprintf(w, "Default rate limit\t%s\n", zeroAs(config.DefaultRateLimitBytesPerSecond, "downloader's own"))
+	fmt.Fprintf(w, "Retry policy\t%s\n", retryPolicyToString(config.RetryPolicy))
+	fmt.Fprintf(w, "History retention\t%s\n", historyRetentionToString(config.HistoryRetention))
+}
+
+func zeroAs[T uint32 | uint64](v T, zero string) string {
+	if v == 0 {
+		return zero
+	}
+	return fmt.Sprint(v)
+}
+
+func retryPolicyToString(policy *queue.RetryPolicy) string {
+	if policy.GetMaxAttempts() <= 1 {
+		return "no retries"
+	}
+	var retryOn []string
+	for _, c := range policy.RetryOn {
+		retryOn = append(retryOn, failureClassToString(c))
+	}
+	if len(retryOn) == 0 {
+		retryOn = []string{"network", "server", "unspecified"}
+	}
+	return fmt.Sprintf("%d attempts, backoff %v (max %v, x%g), on %s",
+		policy.MaxAttempts,
+		policy.InitialBackoff.AsDuration(),
+		policy.MaxBackoff.AsDuration(),
+		policy.Multiplier,
+		strings.Join(retryOn, ", "))
+}
+
+func historyRetentionToString(retention *queue.HistoryRetention) string {
+	var rules []string
+	if retention.GetMaxEntries() > 0 {
+		rules = append(rules, fmt.Sprintf("newest %d items which didn't fail", retention.MaxEntries))
+	}
+	if d := retention.GetMaxAge().AsDuration(); d > 0 {
+		rules = append(rules, fmt.Sprintf("items for %v", d))
+	}
+	if d := retention.GetFailedMaxAge().AsDuration(); d > 0 {
+		rules = append(rules, fmt.Sprintf("failed items for %v", d))
+	}
+	if len(rules) == 0 {
+		return "everything"
+	}
+	return "keep " + strings.Join(rules, ", ")
+}
@@ -4,4 +4,6 @@ const (
 	CodeInvalidArgument = 1
 	CodeNetworkError    = 2
 	CodeInternalError   = 3
+	// CodeDuplicate is returned when an item isn't added because it duplicates another.
+	CodeDuplicate = 4
 )
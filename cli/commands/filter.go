@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	FlagState             = "state"
+	FlagSourceHost        = "source-host"
+	FlagDestinationPrefix = "destination-prefix"
+	FlagUpdatedBefore     = "updated-before"
+	FlagUpdatedAfter      = "updated-after"
+	FlagSort              = "sort"
+	FlagDescending        = "descending"
+)
+
+var sortFields = map[string]queue.ItemSort_Field{
+	"default":     queue.ItemSort_SORT_FIELD_DEFAULT,
+	"updated":     queue.ItemSort_SORT_FIELD_UPDATED,
+	"source":      queue.ItemSort_SORT_FIELD_SOURCE,
+	"destination": queue.ItemSort_SORT_FIELD_DESTINATION,
+	"category":    queue.ItemSort_SORT_FIELD_CATEGORY,
+	"size":        queue.ItemSort_SORT_FIELD_TOTAL_SIZE,
+}
+
+var itemStates = map[string]queue.ItemState_State{
+	"queued":      queue.ItemState_ITEM_STATE_QUEUED,
+	"downloading": queue.ItemState_ITEM_STATE_DOWNLOADING,
+	"failed":      queue.ItemState_ITEM_STATE_FAILED,
+	"complete":    queue.ItemState_ITEM_STATE_COMPLETE,
+	"waiting":     queue.ItemState_ITEM_STATE_WAITING,
+	"blocked":     queue.ItemState_ITEM_STATE_BLOCKED,
+}
+
+// filterFlags returns the flags used to filter and sort item listings.
+func filterFlags() []cli.Flag {
+	return append(matchFlags("show"), []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagSort,
+			Usage: "The field to sort by: default, updated, source, destination, category or size",
+			Value: "default",
+		},
+		&cli.BoolFlag{
+			Name:  FlagDescending,
+			Usage: "Reverse the sort order",
+		},
+	}...)
+}
+
+// matchFlags returns the flags used to choose which items a command acts on.  verb describes
+// what the command does with them, such as "show".
+func matchFlags(verb string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  FlagState,
+			Usage: fmt.Sprintf("Only %s items in the given state (queued, waiting, blocked, downloading, failed or complete). May be repeated", verb),
+		},
+		&cli.StringFlag{
+			Name:    FlagCategory,
+			Aliases: []string{"cat"},
+			Usage:   fmt.Sprintf("Only %s items in the given category", verb),
+		},
+		&cli.StringFlag{
+			Name:  FlagSourceHost,
+			Usage: fmt.Sprintf("Only %s items downloaded from the given host", verb),
+		},
+		&cli.StringFlag{
+			Name:  FlagDestinationPrefix,
+			Usage: fmt.Sprintf("Only %s items whose destination begins with the given prefix", verb),
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdatedBefore,
+			Usage: fmt.Sprintf("Only %s items last updated before the given time. Either a date, an RFC3339 time, or a duration before now such as 24h", verb),
+		},
+		&cli.StringFlag{
+			Name:  FlagUpdatedAfter,
+			Usage: fmt.Sprintf("Only %s items last updated after the given time. Either a date, an RFC3339 time, or a duration before now such as 24h", verb),
+		},
+	}
+}
+
+// filterFromFlags builds the filter and sort parameters for a listing from the command's flags.
+func filterFromFlags(cmd *cli.Command) (*queue.ItemFilter, *queue.ItemSort, error) {
+	filter, err := matchFromFlags(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	field, ok := sortFields[strings.ToLower(cmd.String(FlagSort))]
+	if !ok {
+		return nil, nil, cli.Exit(fmt.Sprintf("unrecognised sort field %q", cmd.String(FlagSort)), CodeInvalidArgument)
+	}
+	return filter, &queue.ItemSort{Field: field, Descending: cmd.Bool(FlagDescending)}, nil
+}
+
+// matchFlagsSet reports whether any of the flags returned by matchFlags were given.
+func matchFlagsSet(cmd *cli.Command) bool {
+	return slices.ContainsFunc([]string{FlagState, FlagCategory, FlagSourceHost, FlagDestinationPrefix, FlagUpdatedBefore, FlagUpdatedAfter}, cmd.IsSet)
+}
+
+// matchFromFlags builds a filter from the flags returned by matchFlags.
+func matchFromFlags(cmd *cli.Command) (*queue.ItemFilter, error) {
+	filter := &queue.ItemFilter{
+		Category:          cmd.String(FlagCategory),
+		SourceHost:        cmd.String(FlagSourceHost),
+		DestinationPrefix: cmd.String(FlagDestinationPrefix),
+	}
+	for _, s := range cmd.StringSlice(FlagState) {
+		state, ok := itemStates[strings.ToLower(s)]
+		if !ok {
+			return nil, cli.Exit(fmt.Sprintf("unrecognised state %q", s), CodeInvalidArgument)
+		}
+		filter.States = append(filter.States, state)
+	}
+
+	var err error
+	if filter.UpdatedBefore, err = parseTimeFlag(cmd, FlagUpdatedBefore); err != nil {
+		return nil, err
+	}
+	if filter.UpdatedAfter, err = parseTimeFlag(cmd, FlagUpdatedAfter); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// parseTimeFlag parses a flag holding either an absolute time or a duration before now.
+func parseTimeFlag(cmd *cli.Command, name string) (*timestamppb.Timestamp, error) {
+	return parseRelativeTimeFlag(cmd, name, -1)
+}
+
+// parseFutureTimeFlag parses a flag holding either an absolute time or a duration after now.
+func parseFutureTimeFlag(cmd *cli.Command, name string) (*timestamppb.Timestamp, error) {
+	return parseRelativeTimeFlag(cmd, name, 1)
+}
+
+// parseRelativeTimeFlag parses a flag holding either an absolute time or a duration, which is
+// multiplied by direction and added to the current time.
+func parseRelativeTimeFlag(cmd *cli.Command, name string, direction time.Duration) (*timestamppb.Timestamp, error) {
+	str := strings.TrimSpace(cmd.String(name))
+	if str == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(str); err == nil {
+		return timestamppb.New(time.Now().Add(direction * d)), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, str, time.Local); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, cli.Exit(fmt.Sprintf("%s: could not parse %q as a time or duration", name, str), CodeInvalidArgument)
+}
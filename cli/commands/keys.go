@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	FlagRole   = "role"
+	ArgKeyName = "name"
+)
+
+// roleName returns the name a role is given on the command line, which is its name in the proto
+// without the prefix, in lower case and hyphenated, such as read-only.  The queue service names
+// roles in the same way.
+func roleName(role queue.Role) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(role.String(), "ROLE_"), "_", "-"))
+}
+
+// parseRole returns the role with the given name, as returned by roleName.
+func parseRole(name string) (queue.Role, bool) {
+	for _, value := range queue.Role_value {
+		if role := queue.Role(value); role != queue.Role_ROLE_UNSPECIFIED && roleName(role) == name {
+			return role, true
+		}
+	}
+	return queue.Role_ROLE_UNSPECIFIED, false
+}
+
+func keyNameArg() cli.Argument {
+	return &cli.StringArg{
+		Name:      ArgKeyName,
+		UsageText: "The name of the key",
+	}
+}
+
+func CreateKey() *cli.Command {
+	return &cli.Command{
+		Name:      "key",
+		Usage:     "Create an API key, and print its secret",
+		Action:    createKey,
+		Arguments: []cli.Argument{keyNameArg()},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagRole,
+				Usage: "The key's role: read-only, client, worker or admin",
+				Value: "client",
+			},
+		},
+	}
+}
+
+func RotateKey() *cli.Command {
+	return &cli.Command{
+		Name:      "key",
+		Usage:     "Replace an API key's secret, and print the new one",
+		Action:    rotateKey,
+		Arguments: []cli.Argument{keyNameArg()},
+	}
+}
+
+func RevokeKey() *cli.Command {
+	return &cli.Command{
+		Name:      "key",
+		Usage:     "Revoke an API key",
+		Action:    revokeKey,
+		Arguments: []cli.Argument{keyNameArg()},
+	}
+}
+
+func ShowKeys() *cli.Command {
+	return &cli.Command{
+		Name:   "keys",
+		Usage:  "Show the API keys the queue service accepts",
+		Action: showKeys,
+	}
+}
+
+func createKey(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgKeyName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	role, ok := parseRole(cmd.String(FlagRole))
+	if !ok {
+		return cli.Exit(fmt.Sprintf("unrecognised role %q", cmd.String(FlagRole)), CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.CreateKey(ctx, &queue.CreateKeyInput{Name: name, Role: role})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error creating key: %v", err), CodeInternalError)
+	}
+	fmt.Println(res.Secret)
+	return nil
+}
+
+func rotateKey(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgKeyName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.RotateKey(ctx, &queue.RotateKeyInput{Name: name})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error rotating key: %v", err), CodeInternalError)
+	}
+	fmt.Println(res.Secret)
+	return nil
+}
+
+func revokeKey(ctx context.Context, cmd *cli.Command) error {
+	name := cmd.StringArg(ArgKeyName)
+	if name == "" {
+		return cli.Exit("name is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.RevokeKey(ctx, &queue.RevokeKeyInput{Name: name})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error revoking key: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func showKeys(ctx context.Context, cmd *cli.Command) error {
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.ListKeys(ctx, &queue.ListKeysInput{})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error fetching keys: %v", err), CodeInternalError)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "Name\tRole\tCreated\tRotated\n")
+	for _, key := range res.Keys {
+		rotated := ""
+		if key.Rotated != nil {
+			rotated = key.Rotated.AsTime().Local().Format(time.DateTime)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key.Name, roleName(key.Role), key.Created.AsTime().Local().Format(time.DateTime), rotated)
+	}
+	return nil
+}
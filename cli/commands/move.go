@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+)
+
+func Move() *cli.Command {
+	return &cli.Command{
+		Name:  "move",
+		Usage: "Change the position of an item in its queue",
+		Commands: []*cli.Command{
+			{
+				Name:      "front",
+				Usage:     "Move an item to the front of its queue",
+				ArgsUsage: "<item_id>",
+				Action:    moveToFront,
+				Arguments: []cli.Argument{itemIDArg()},
+			},
+			{
+				Name:      "back",
+				Usage:     "Move an item to the back of its queue",
+				ArgsUsage: "<item_id>",
+				Action:    moveToBack,
+				Arguments: []cli.Argument{itemIDArg()},
+			},
+			{
+				Name:      "before",
+				Usage:     "Move an item so that it is downloaded immediately before another",
+				ArgsUsage: "<item_id> <other_item_id>",
+				Action:    moveRelative(queue.MoveItemInput_PLACEMENT_BEFORE),
+				Arguments: []cli.Argument{itemIDArg(), otherItemIDArg()},
+			},
+			{
+				Name:      "after",
+				Usage:     "Move an item so that it is downloaded immediately after another",
+				ArgsUsage: "<item_id> <other_item_id>",
+				Action:    moveRelative(queue.MoveItemInput_PLACEMENT_AFTER),
+				Arguments: []cli.Argument{itemIDArg(), otherItemIDArg()},
+			},
+		},
+	}
+}
+
+func itemIDArg() cli.Argument {
+	return &cli.StringArg{
+		Name:      ArgItemID,
+		UsageText: "The ID of the item to move",
+	}
+}
+
+func otherItemIDArg() cli.Argument {
+	return &cli.StringArg{
+		Name:      ArgOtherID,
+		UsageText: "The ID of the item to place the moved item next to",
+	}
+}
+
+func moveToFront(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgItemID)
+	if id == "" {
+		return cli.Exit("item_id is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.MoveToFront(ctx, &queue.MoveToFrontInput{Item: &queue.Identifier{Id: id}})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error moving item: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func moveToBack(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgItemID)
+	if id == "" {
+		return cli.Exit("item_id is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.MoveToBack(ctx, &queue.MoveToBackInput{Item: &queue.Identifier{Id: id}})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error moving item: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func moveRelative(placement queue.MoveItemInput_Placement) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		id := cmd.StringArg(ArgItemID)
+		other := cmd.StringArg(ArgOtherID)
+		if id == "" || other == "" {
+			return cli.Exit("item_id and other_item_id are required", CodeInvalidArgument)
+		}
+		client, err := getRPCClient(cmd)
+		if err != nil {
+			return err
+		}
+		_, err = client.MoveItem(ctx, &queue.MoveItemInput{
+			Item:       &queue.Identifier{Id: id},
+			RelativeTo: &queue.Identifier{Id: other},
+			Placement:  placement,
+		})
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("error moving item: %v", err), CodeInternalError)
+		}
+		return nil
+	}
+}
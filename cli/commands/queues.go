@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"os"
+)
+
+const (
+	FlagArchive  = "archive"
+	ArgQueueID   = "queue_id"
+	ArgQueueName = "new_name"
+)
+
+func queueIDArg() cli.Argument {
+	return &cli.StringArg{
+		Name:      ArgQueueID,
+		UsageText: "The id of the queue, as shown by show queues",
+	}
+}
+
+func DeleteQueue() *cli.Command {
+	return &cli.Command{
+		Name:      "queue",
+		Usage:     "Delete a queue, with its items and history",
+		ArgsUsage: "<queue_id>",
+		Action:    deleteQueue,
+		Arguments: []cli.Argument{queueIDArg()},
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  FlagArchive,
+				Usage: "Archive the queue on the queue host first, to a file its import command can restore the queue from",
+			},
+		},
+	}
+}
+
+func RenameQueue() *cli.Command {
+	return &cli.Command{
+		Name:      "queue",
+		Usage:     "Change the name a queue is shown with. Its id is unchanged",
+		ArgsUsage: "<queue_id> <new_name>",
+		Action:    renameQueue,
+		Arguments: []cli.Argument{
+			queueIDArg(),
+			&cli.StringArg{
+				Name:      ArgQueueName,
+				UsageText: "The queue's new name",
+			},
+		},
+	}
+}
+
+func PauseQueue() *cli.Command {
+	return &cli.Command{
+		Name:      "queue",
+		Usage:     "Stop a queue's items from being downloaded. Items can still be added",
+		ArgsUsage: "<queue_id>",
+		Action:    pauseQueue,
+		Arguments: []cli.Argument{queueIDArg()},
+	}
+}
+
+func ResumeQueue() *cli.Command {
+	return &cli.Command{
+		Name:      "queue",
+		Usage:     "Let a paused queue's items be downloaded again",
+		ArgsUsage: "<queue_id>",
+		Action:    resumeQueue,
+		Arguments: []cli.Argument{queueIDArg()},
+	}
+}
+
+func deleteQueue(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgQueueID)
+	if id == "" {
+		return cli.Exit("queue_id is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.DeleteQueue(ctx, &queue.DeleteQueueInput{
+		Queue:   &queue.Identifier{Id: id},
+		Archive: cmd.Bool(FlagArchive),
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error deleting queue: %v", err), CodeInternalError)
+	}
+	if res.ArchivePath != "" {
+		fmt.Fprintln(os.Stderr, "queue archived to", res.ArchivePath)
+	}
+	return nil
+}
+
+func renameQueue(ctx context.Context, cmd *cli.Command) error {
+	id, name := cmd.StringArg(ArgQueueID), cmd.StringArg(ArgQueueName)
+	if id == "" || name == "" {
+		return cli.Exit("queue_id and new_name are required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.RenameQueue(ctx, &queue.RenameQueueInput{Queue: &queue.Identifier{Id: id}, Name: name})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error renaming queue: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func pauseQueue(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgQueueID)
+	if id == "" {
+		return cli.Exit("queue_id is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.PauseQueue(ctx, &queue.PauseQueueInput{Queue: &queue.Identifier{Id: id}})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error pausing queue: %v", err), CodeInternalError)
+	}
+	return nil
+}
+
+func resumeQueue(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgQueueID)
+	if id == "" {
+		return cli.Exit("queue_id is required", CodeInvalidArgument)
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.ResumeQueue(ctx, &queue.ResumeQueueInput{Queue: &queue.Identifier{Id: id}})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error resuming queue: %v", err), CodeInternalError)
+	}
+	return nil
+}
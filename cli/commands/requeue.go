@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"os"
+	"text/tabwriter"
+)
+
+const (
+	ArgItemIDs = "item_ids"
+	FlagAll    = "all"
+)
+
+func Requeue() *cli.Command {
+	return &cli.Command{
+		Name:      "requeue",
+		Usage:     "Queue finished items to be downloaded again, chosen by id or by filter. Failed items carry on from where they stopped if they can",
+		ArgsUsage: "[item_id...]",
+		Action:    requeue,
+		Arguments: []cli.Argument{
+			&cli.StringArgs{
+				Name:      ArgItemIDs,
+				UsageText: "The IDs of the finished items to requeue",
+				Min:       0,
+				Max:       -1,
+			},
+		},
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagQueue,
+				Value: DefQueue,
+			},
+			&cli.BoolFlag{
+				Name:  FlagAll,
+				Usage: "Requeue every finished item which matches the filter flags, or the whole history if none are given",
+			},
+		}, matchFlags("requeue")...),
+	}
+}
+
+func requeue(ctx context.Context, cmd *cli.Command) error {
+	input := &queue.RequeueItemsInput{
+		Queue: &queue.Identifier{Id: cmd.String(FlagQueue)},
+	}
+	for _, id := range cmd.StringArgs(ArgItemIDs) {
+		input.Items = append(input.Items, &queue.Identifier{Id: id})
+	}
+
+	filtered := matchFlagsSet(cmd)
+	switch {
+	case len(input.Items) > 0 && (filtered || cmd.Bool(FlagAll)):
+		return cli.Exit("give either item ids or filter flags, not both", CodeInvalidArgument)
+	case len(input.Items) == 0 && !filtered && !cmd.Bool(FlagAll):
+		return cli.Exit("give the ids of the items to requeue, filter flags such as --state failed, or --all", CodeInvalidArgument)
+	case len(input.Items) == 0:
+		filter, err := matchFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		input.Filter = filter
+	}
+
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.RequeueItems(ctx, input)
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error requeueing items: %v", err), CodeInternalError)
+	}
+
+	fmt.Fprintf(os.Stderr, "%d items requeued\n", len(res.Items))
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "ID\tRequeued from\tSource\tDestination\tResume from\n")
+	for _, item := range res.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", item.Id.Id, item.RequeuedFrom.GetId(), item.Item.Source.Url, item.Item.Destination.Url, item.State.DownloadedBytes)
+	}
+	return nil
+}
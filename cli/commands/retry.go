@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"os"
+	"strings"
+)
+
+const (
+	FlagMaxAttempts       = "max-attempts"
+	FlagBackoff           = "backoff"
+	FlagMaxBackoff        = "max-backoff"
+	FlagBackoffMultiplier = "backoff-multiplier"
+	FlagRetryOn           = "retry-on"
+)
+
+var failureClasses = map[string]queue.FailureClass{
+	"unspecified": queue.FailureClass_FAILURE_CLASS_UNSPECIFIED,
+	"network":     queue.FailureClass_FAILURE_CLASS_NETWORK,
+	"server":      queue.FailureClass_FAILURE_CLASS_SERVER,
+	"not-found":   queue.FailureClass_FAILURE_CLASS_NOT_FOUND,
+	"client":      queue.FailureClass_FAILURE_CLASS_CLIENT,
+	"local":       queue.FailureClass_FAILURE_CLASS_LOCAL,
+	"invalid":     queue.FailureClass_FAILURE_CLASS_INVALID,
+}
+
+// retryFlags returns the flags used to describe a retry policy.
+func retryFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.UintFlag{
+			Name:  FlagMaxAttempts,
+			Usage: "The maximum number of times to attempt the download, including the first",
+		},
+		&cli.DurationFlag{
+			Name:  FlagBackoff,
+			Usage: "The time to wait before the first retry (default: 1m)",
+		},
+		&cli.DurationFlag{
+			Name:  FlagMaxBackoff,
+			Usage: "The longest time to wait between attempts (default: 1h)",
+		},
+		&cli.FloatFlag{
+			Name:  FlagBackoffMultiplier,
+			Usage: "The factor by which the wait grows with each attempt (default: 2)",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagRetryOn,
+			Usage: "The failures to retry: network, server, not-found, client, local, invalid or unspecified. May be repeated (default: network, server and unspecified)",
+		},
+	}
+}
+
+// retryPolicyFromFlags builds a retry policy from the command's flags.  It returns nil if none of
+// the flags were set.
+func retryPolicyFromFlags(cmd *cli.Command) (*queue.RetryPolicy, error) {
+	isSet := false
+	for _, name := range []string{FlagMaxAttempts, FlagBackoff, FlagMaxBackoff, FlagBackoffMultiplier, FlagRetryOn} {
+		isSet = isSet || cmd.IsSet(name)
+	}
+	if !isSet {
+		return nil, nil
+	}
+
+	policy := &queue.RetryPolicy{
+		MaxAttempts: uint32(cmd.Uint(FlagMaxAttempts)),
+		Multiplier:  cmd.Float(FlagBackoffMultiplier),
+	}
+	if cmd.IsSet(FlagBackoff) {
+		policy.InitialBackoff = durationpb.New(cmd.Duration(FlagBackoff))
+	}
+	if cmd.IsSet(FlagMaxBackoff) {
+		policy.MaxBackoff = durationpb.New(cmd.Duration(FlagMaxBackoff))
+	}
+	for _, c := range cmd.StringSlice(FlagRetryOn) {
+		class, ok := failureClasses[strings.ToLower(c)]
+		if !ok {
+			return nil, cli.Exit(fmt.Sprintf("unrecognised failure class %q", c), CodeInvalidArgument)
+		}
+		policy.RetryOn = append(policy.RetryOn, class)
+	}
+	return policy, nil
+}
+
+func SetRetryPolicy() *cli.Command {
+	return &cli.Command{
+		Name:   "retry-policy",
+		Usage:  "Set the retry policy for items in a queue which don't have their own. With no policy flags, retries are disabled",
+		Action: setRetryPolicy,
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagQueue,
+				Value: DefQueue,
+			},
+		}, retryFlags()...),
+	}
+}
+
+func setRetryPolicy(ctx context.Context, cmd *cli.Command) error {
+	policy, err := retryPolicyFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	_, err = client.SetQueueRetryPolicy(ctx, &queue.SetQueueRetryPolicyInput{
+		Queue:       &queue.Identifier{Id: cmd.String(FlagQueue)},
+		RetryPolicy: policy,
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error setting the retry policy: %v", err), CodeInternalError)
+	}
+	fmt.Fprintln(os.Stderr, "retry policy set")
+	return nil
+}
+
+func failureClassToString(class queue.FailureClass) string {
+	for name, c := range failureClasses {
+		if c == class {
+			return name
+		}
+	}
+	return queue.FailureClass_name[int32(class)]
+}
@@ -5,23 +5,40 @@ import (
 	"fmt"
 	"github.com/harryrose/godm/cli/queue"
 	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 )
 
 type Stringer interface {
 	String(key string) string
+	Bool(key string) bool
 }
 
 func getRPCClient(cliCtx Stringer) (queue.QueueServiceClient, error) {
+	key := cliCtx.String(ArgKey)
+	if key == "" && cliCtx.String(ArgTLSCert) == "" {
+		return nil, cli.Exit("a key or a client certificate is required", CodeInvalidArgument)
+	}
+	creds, err := transportCredentials(cliCtx)
+	if err != nil {
+		return nil, cli.Exit(err.Error(), CodeInvalidArgument)
+	}
 	con, err := grpc.Dial(
 		cliCtx.String(ArgQueueHost),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", cliCtx.String(ArgKey))
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if key != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", key)
+			}
 			return invoker(ctx, method, req, reply, cc, opts...)
 		}),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			if key != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", key)
+			}
+			return streamer(ctx, desc, cc, method, opts...)
+		}),
 	)
 	if err != nil {
 		return nil, cli.Exit(fmt.Sprintf("error connecting to queue host: %v", err), CodeNetworkError)
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/url"
+	"os"
+	"text/tabwriter"
+)
+
+const (
+	FlagSource      = "source"
+	FlagDestination = "destination"
+	FlagNextAttempt = "next-attempt"
+)
+
+func SetItem() *cli.Command {
+	return &cli.Command{
+		Name:      "item",
+		Usage:     "Change the source, destination or category of a queued item, keeping its place in the queue",
+		ArgsUsage: "<item_id>",
+		Action:    setItem,
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:      ArgItemID,
+				UsageText: "The ID of the item to change",
+			},
+		},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  FlagSource,
+				Usage: "The URL to download the item from",
+			},
+			&cli.StringFlag{
+				Name:  FlagDestination,
+				Usage: "The path to download the file to. Note that this is relative to the downloader's path.",
+			},
+			&cli.StringFlag{
+				Name:    FlagCategory,
+				Aliases: []string{"cat"},
+				Usage:   "The item's category. Empty for the queue's default category",
+			},
+			&cli.BoolFlag{
+				Name:  FlagNextAttempt,
+				Usage: "If the item is downloading, use the new source for its next attempt rather than failing. Only --source can be given",
+			},
+		},
+	}
+}
+
+func setItem(ctx context.Context, cmd *cli.Command) error {
+	id := cmd.StringArg(ArgItemID)
+	if id == "" {
+		return cli.Exit("item_id is required", CodeInvalidArgument)
+	}
+
+	changes := &queue.Item{}
+	mask := &fieldmaskpb.FieldMask{}
+	if cmd.IsSet(FlagSource) {
+		srcUrl, err := url.Parse(cmd.String(FlagSource))
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("source url was invalid: %v", err), CodeInvalidArgument)
+		}
+		if len(srcUrl.Scheme) == 0 {
+			return cli.Exit("source url is missing a scheme", CodeInvalidArgument)
+		}
+		changes.Source = &queue.Target{Url: srcUrl.String()}
+		mask.Paths = append(mask.Paths, "source")
+	}
+	if cmd.IsSet(FlagDestination) {
+		dstUrl, err := url.Parse(cmd.String(FlagDestination))
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("destination url is invalid: %v", err), CodeInvalidArgument)
+		}
+		if len(dstUrl.Scheme) == 0 {
+			dstUrl.Scheme = "file"
+		} else if dstUrl.Scheme != "file" {
+			return cli.Exit("only file:// destination urls are supported", CodeInvalidArgument)
+		}
+		changes.Destination = &queue.Target{Url: dstUrl.String()}
+		mask.Paths = append(mask.Paths, "destination")
+	}
+	if cmd.IsSet(FlagCategory) {
+		changes.Category = &queue.Category{Id: &queue.Identifier{Id: cmd.String(FlagCategory)}}
+		mask.Paths = append(mask.Paths, "category")
+	}
+	if len(mask.Paths) == 0 {
+		return cli.Exit("at least one of --source, --destination or --category must be given", CodeInvalidArgument)
+	}
+
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.UpdateItem(ctx, &queue.UpdateItemInput{
+		Item:        &queue.Identifier{Id: id},
+		Changes:     changes,
+		UpdateMask:  mask,
+		NextAttempt: cmd.Bool(FlagNextAttempt),
+	})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error changing the item: %v", err), CodeInternalError)
+	}
+
+	item := res.Item
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "ID\t%s\n", item.Id.Id)
+	fmt.Fprintf(w, "Source\t%s\n", item.Item.Source.Url)
+	if item.NextSource != nil {
+		fmt.Fprintf(w, "Next attempt source\t%s\n", item.NextSource.Url)
+	}
+	fmt.Fprintf(w, "Destination\t%s\n", item.Item.Destination.Url)
+	fmt.Fprintf(w, "Category\t%s\n", item.Item.Category.Id.Id)
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli/v3"
 	"os"
 	"text/tabwriter"
+	"time"
 )
 
 func ShowHistory() *cli.Command {
@@ -14,12 +15,12 @@ func ShowHistory() *cli.Command {
 		Name:   "history",
 		Usage:  "Show a queue's finished items and their status",
 		Action: showHistory,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:  FlagQueue,
 				Value: DefQueue,
 			},
-		},
+		}, filterFlags()...),
 	}
 }
 
@@ -28,9 +29,14 @@ func showHistory(ctx context.Context, cmd *cli.Command) error {
 	if err != nil {
 		return err
 	}
+	filter, sort, err := filterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
 	defer w.Flush()
-	fmt.Fprintf(w, "Source\tDestination\tState\tSize\tMessage")
+	fmt.Fprintf(w, "Finished\tSource\tDestination\tState\tSize\tAttempts\tMessage")
 	next := ""
 	for {
 		got, err := client.GetFinishedItems(ctx, &queue.GetFinishedItemsInput{
@@ -43,13 +49,15 @@ func showHistory(ctx context.Context, cmd *cli.Command) error {
 					Id: next,
 				},
 			},
+			Filter: filter,
+			Sort:   sort,
 		})
 		if err != nil {
 			return cli.Exit(fmt.Sprintf("error fetching queue history items: %v", err), CodeInternalError)
 		}
 		next = got.Pagination.Next.Id
 		for _, item := range got.Items {
-			fmt.Fprintf(w, "\n%s\t%s\t%s\t%d\t%s", item.Item.Source.Url, item.Item.Destination.Url, stateToString(item.State.State), item.State.TotalSizeBytes, item.State.Message)
+			fmt.Fprintf(w, "\n%s\t%s\t%s\t%s\t%d\t%d\t%s", item.Updated.AsTime().Local().Format(time.DateTime), item.Item.Source.Url, item.Item.Destination.Url, stateToString(item.State.State), item.State.TotalSizeBytes, attemptCount(item), item.State.Message)
 		}
 		if next == "" {
 			return nil
@@ -65,9 +73,26 @@ func stateToString(state queue.ItemState_State) string {
 		return "Complete"
 	case queue.ItemState_ITEM_STATE_FAILED:
 		return "Failed"
+	case queue.ItemState_ITEM_STATE_QUEUED:
+		return "Queued"
+	case queue.ItemState_ITEM_STATE_WAITING:
+		return "Waiting"
+	case queue.ItemState_ITEM_STATE_BLOCKED:
+		return "Blocked"
 	case queue.ItemState_ITEM_STATE_UNSPECIFIED:
 		return "Unspecified"
 	default:
 		return queue.ItemState_State_name[int32(state)]
 	}
 }
+
+// attemptCount returns the number of times a download of the item has been attempted.  Only
+// failed attempts are recorded, so an attempt which is underway or succeeded is added.
+func attemptCount(item *queue.IdentifiedQueueItemWithState) int {
+	switch item.State.State {
+	case queue.ItemState_ITEM_STATE_DOWNLOADING, queue.ItemState_ITEM_STATE_COMPLETE:
+		return len(item.Attempts) + 1
+	default:
+		return len(item.Attempts)
+	}
+}
@@ -2,11 +2,22 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/harryrose/godm/cli/queue"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
 	"os"
+	"os/signal"
+	"syscall"
 	"text/tabwriter"
+	"time"
+)
+
+const (
+	watchReconnectDelay = 2 * time.Second
 )
 
 func ShowQueue() *cli.Command {
@@ -14,12 +25,17 @@ func ShowQueue() *cli.Command {
 		Name:   "queue",
 		Usage:  "Show a queue's items and their status",
 		Action: showQueue,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:  FlagQueue,
 				Value: DefQueue,
 			},
-		},
+			&cli.BoolFlag{
+				Name:    FlagWatch,
+				Aliases: []string{"w"},
+				Usage:   "Keep running and print changes to the queue as they happen",
+			},
+		}, filterFlags()...),
 	}
 }
 
@@ -28,9 +44,18 @@ func showQueue(ctx context.Context, cmd *cli.Command) error {
 	if err != nil {
 		return err
 	}
+	if cmd.Bool(FlagWatch) {
+		return watchQueue(ctx, client, cmd.String(FlagQueue))
+	}
+
+	filter, sort, err := filterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
 	defer w.Flush()
-	fmt.Fprintf(w, "Source\tDestination\tDownloaded\tTotal\t%%")
+	fmt.Fprintf(w, "ID\tPriority\tState\tSource\tDestination\tDownloaded\tTotal\t%%\tAttempts\tWorker")
 	next := ""
 	for {
 		got, err := client.GetQueueItems(ctx, &queue.GetQueueItemsInput{
@@ -43,20 +68,140 @@ func showQueue(ctx context.Context, cmd *cli.Command) error {
 					Id: next,
 				},
 			},
+			Filter: filter,
+			Sort:   sort,
 		})
 		if err != nil {
 			return cli.Exit(fmt.Sprintf("error fetching queue items: %v", err), CodeInternalError)
 		}
 		next = got.Pagination.Next.Id
 		for _, item := range got.Items {
-			perc := 0.0
-			if item.State.TotalSizeBytes != 0 {
-				perc = float64(item.State.DownloadedBytes) / float64(item.State.TotalSizeBytes)
-			}
-			fmt.Fprintf(w, "\n%s\t%s\t%d\t%d\t%4.1f", item.Item.Source.Url, item.Item.Destination.Url, item.State.DownloadedBytes, item.State.TotalSizeBytes, perc*100.0)
+			fmt.Fprintf(w, "\n%s\t%d\t%s\t%s\t%s\t%d\t%d\t%4.1f\t%d\t%s", item.Id.Id, item.Item.Priority, queuedStateToString(item), item.Item.Source.Url, item.Item.Destination.Url, item.State.DownloadedBytes, item.State.TotalSizeBytes, percentage(item.State), attemptCount(item), item.ClaimedBy)
 		}
 		if next == "" {
 			return nil
 		}
 	}
 }
+
+// watchQueue prints the queue's current items followed by each change made to the queue.
+// If the connection drops, it reconnects and resumes from the last event it received.  It
+// watches until it is interrupted, which is a clean exit.
+func watchQueue(ctx context.Context, client queue.QueueServiceClient, queueName string) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var resumeFrom uint64
+	for {
+		stream, err := client.WatchQueue(ctx, &queue.WatchQueueInput{
+			Queue:      &queue.Identifier{Id: queueName},
+			ResumeFrom: resumeFrom,
+		})
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil
+		}
+		if err != nil {
+			return cli.Exit(fmt.Sprintf("error watching queue: %v", err), CodeNetworkError)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				w.Flush()
+				if errors.Is(ctx.Err(), context.Canceled) {
+					return nil
+				}
+				if !isResumable(err) {
+					return cli.Exit(fmt.Sprintf("error watching queue: %v", err), CodeNetworkError)
+				}
+				break
+			}
+			resumeFrom = ev.Sequence
+
+			switch ev.Type {
+			case queue.QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT:
+				item := ev.Item
+				fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%d\t%d\t%4.1f\t%d\t%s\n", item.Id.Id, item.Item.Priority, queuedStateToString(item), item.Item.Source.Url, item.Item.Destination.Url, item.State.DownloadedBytes, item.State.TotalSizeBytes, percentage(item.State), attemptCount(item), item.ClaimedBy)
+
+			case queue.QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE:
+				w.Flush()
+
+			default:
+				printEvent(ev)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchReconnectDelay):
+		}
+	}
+}
+
+func printEvent(ev *queue.QueueEvent) {
+	ts := ev.Timestamp.AsTime().Local().Format(time.TimeOnly)
+	item := ev.Item
+	switch ev.Type {
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED:
+		fmt.Fprintf(os.Stdout, "%s enqueued  %s %s -> %s\n", ts, item.Id.Id, item.Item.Source.Url, item.Item.Destination.Url)
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_CLAIMED:
+		fmt.Fprintf(os.Stdout, "%s claimed   %s %s\n", ts, item.Id.Id, item.ClaimedBy)
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_UPDATED:
+		switch item.State.State {
+		case queue.ItemState_ITEM_STATE_WAITING:
+			fmt.Fprintf(os.Stdout, "%s retrying  %s at %s after %s failure: %s\n", ts, item.Id.Id, item.NotBefore.AsTime().Local().Format(time.TimeOnly), failureClassToString(item.State.FailureClass), item.State.Message)
+			return
+		case queue.ItemState_ITEM_STATE_BLOCKED:
+			fmt.Fprintf(os.Stdout, "%s blocked   %s %s\n", ts, item.Id.Id, item.State.Message)
+			return
+		case queue.ItemState_ITEM_STATE_QUEUED:
+			fmt.Fprintf(os.Stdout, "%s ready     %s\n", ts, item.Id.Id)
+			return
+		}
+		fmt.Fprintf(os.Stdout, "%s progress  %s %d/%d %4.1f%%\n", ts, item.Id.Id, item.State.DownloadedBytes, item.State.TotalSizeBytes, percentage(item.State))
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_CANCELLED:
+		fmt.Fprintf(os.Stdout, "%s cancelled %s\n", ts, item.Id.Id)
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_MOVED:
+		fmt.Fprintf(os.Stdout, "%s moved     %s priority %d\n", ts, item.Id.Id, item.Item.Priority)
+	case queue.QueueEvent_QUEUE_EVENT_TYPE_FINISHED:
+		fmt.Fprintf(os.Stdout, "%s finished  %s %s %s\n", ts, item.Id.Id, stateToString(item.State.State), item.State.Message)
+	}
+}
+
+// queuedStateToString describes the state of an item in the queue, including when a waiting
+// item will next be attempted, why a blocked item is blocked and how often a recurring item
+// repeats.
+func queuedStateToString(item *queue.IdentifiedQueueItemWithState) string {
+	state := stateToString(item.State.State)
+	if item.State.State == queue.ItemState_ITEM_STATE_WAITING && item.NotBefore != nil {
+		state = fmt.Sprintf("Waiting until %s", item.NotBefore.AsTime().Local().Format(time.DateTime))
+	}
+	if item.State.State == queue.ItemState_ITEM_STATE_BLOCKED {
+		state = fmt.Sprintf("Blocked, %s", item.State.Message)
+	}
+	if item.Item.Recurrence != "" {
+		state = fmt.Sprintf("%s (%s)", state, item.Item.Recurrence)
+	}
+	return state
+}
+
+func isResumable(err error) bool {
+	if errors.Is(err, io.EOF) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+func percentage(state *queue.ItemState) float64 {
+	if state.TotalSizeBytes == 0 {
+		return 0
+	}
+	return float64(state.DownloadedBytes) / float64(state.TotalSizeBytes) * 100.0
+}
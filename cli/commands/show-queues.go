@@ -6,6 +6,8 @@ import (
 	"github.com/harryrose/godm/cli/queue"
 	"github.com/urfave/cli/v3"
 	"os"
+	"text/tabwriter"
+	"time"
 )
 
 func ShowQueues() *cli.Command {
@@ -25,8 +27,16 @@ func showQueues(ctx context.Context, cmd *cli.Command) error {
 	if err != nil {
 		return cli.Exit("error fetching queues", CodeInternalError)
 	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "ID\tName\tCreated\tPaused\n")
 	for _, q := range qs.Queues {
-		fmt.Fprintf(os.Stdout, "%s\n", q.Name)
+		paused := "no"
+		if q.Paused {
+			paused = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", q.GetId().GetId(), q.Name, q.Created.AsTime().Local().Format(time.DateTime), paused)
 	}
 	return nil
 }
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/cli/queue"
+	"github.com/urfave/cli/v3"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+func ShowWorkers() *cli.Command {
+	return &cli.Command{
+		Name:   "workers",
+		Usage:  "Show the downloaders registered with the queue service and what they are downloading",
+		Action: showWorkers,
+	}
+}
+
+func showWorkers(ctx context.Context, cmd *cli.Command) error {
+	client, err := getRPCClient(cmd)
+	if err != nil {
+		return err
+	}
+	res, err := client.ListWorkers(ctx, &queue.ListWorkersInput{})
+	if err != nil {
+		return cli.Exit(fmt.Sprintf("error fetching workers: %v", err), CodeInternalError)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "ID\tHost\tVersion\tSchemes\tRate Limit\tCurrent Item\tLast Seen\n")
+	for _, worker := range res.Workers {
+		rateLimit := "unlimited"
+		if worker.RateLimitBytesPerSecond > 0 {
+			rateLimit = fmt.Sprint(worker.RateLimitBytesPerSecond)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", worker.Id, worker.Host, worker.Version, strings.Join(worker.Schemes, ","), rateLimit, worker.GetCurrentItem().GetId(), worker.LastSeen.AsTime().Local().Format(time.DateTime))
+	}
+	return nil
+}
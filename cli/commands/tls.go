@@ -0,0 +1,12 @@
+package commands
+
+import (
+	"github.com/harryrose/godm/log/clienttls"
+	"google.golang.org/grpc/credentials"
+)
+
+// transportCredentials returns the credentials to connect to the queue host with.  The connection
+// is encrypted if --tls is set, or if any of the TLS files are given.
+func transportCredentials(cliCtx Stringer) (credentials.TransportCredentials, error) {
+	return clienttls.Credentials(cliCtx.Bool(ArgTLS), cliCtx.String(ArgTLSCA), cliCtx.String(ArgTLSCert), cliCtx.String(ArgTLSKey))
+}
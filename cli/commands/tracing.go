@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/harryrose/godm/log/tracing"
+	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"os"
+	"sync"
+)
+
+// StartTracing sends spans to the named exporter, and starts a span for the command, which the
+// calls it makes to the queue host are part of.  endpoint is the address or URL of the OTLP
+// collector; an address or http:// URL is connected to without TLS.  The stdout exporter writes
+// to stderr, so that it doesn't mix with the command's output.
+//
+// The returned function ends the command's span, recording err if it isn't nil, and flushes any
+// spans which haven't been sent.  It may be called more than once.
+func StartTracing(ctx context.Context, exporter string, endpoint string) (context.Context, func(err error), error) {
+	shutdown, err := tracing.Init(ctx, tracing.Config{
+		Exporter: exporter,
+		Endpoint: endpoint,
+		Service:  "godm",
+		Stdout:   os.Stderr,
+	})
+	if errors.Is(err, tracing.ErrInvalidConfig) {
+		return nil, nil, cli.Exit(err.Error(), CodeInvalidArgument)
+	}
+	if err != nil {
+		return nil, nil, cli.Exit(err.Error(), CodeInternalError)
+	}
+	if exporter == "" || exporter == tracing.ExporterNone {
+		return ctx, func(error) {}, nil
+	}
+
+	ctx, span := otel.Tracer("github.com/harryrose/godm/cli").Start(ctx, "godm")
+	var once sync.Once
+	return ctx, func(err error) {
+		once.Do(func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+			if err := shutdown(context.Background()); err != nil {
+				fmt.Fprintf(os.Stderr, "unable to send traces: %v\n", err)
+			}
+		})
+	}, nil
+}
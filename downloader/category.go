@@ -0,0 +1,69 @@
+package downloader
+
+import (
+	"fmt"
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/downloader/reader"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const fileScheme = "file://"
+
+// checkAllowedHost returns an error if the category doesn't allow items to be downloaded from
+// src's host.  Categories without a host list allow any host.
+func checkAllowedHost(category *queue.CategoryConfig, src string) error {
+	allowed := category.GetAllowedHosts()
+	if len(allowed) == 0 {
+		return nil
+	}
+	u, err := url.Parse(src)
+	if err != nil {
+		return fail(queue.FailureClass_FAILURE_CLASS_INVALID, fmt.Errorf("source url is invalid: %w", err))
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, entry := range allowed {
+		if host == entry {
+			return nil
+		}
+		if parent, ok := strings.CutPrefix(entry, "*."); ok && (host == parent || strings.HasSuffix(host, "."+parent)) {
+			return nil
+		}
+	}
+	return fail(queue.FailureClass_FAILURE_CLASS_INVALID, fmt.Errorf("category %v doesn't allow downloads from %v", category.GetId().GetId(), host))
+}
+
+// restrictRedirects stops rdr from following redirects to hosts the category doesn't allow.
+func restrictRedirects(category *queue.CategoryConfig, rdr reader.OpenReadCloser) {
+	src, ok := rdr.(*reader.HTTPSourceConfiguration)
+	if !ok || len(category.GetAllowedHosts()) == 0 {
+		return
+	}
+	src.CheckRedirect = func(u *url.URL) error {
+		return checkAllowedHost(category, u.String())
+	}
+}
+
+// categoryDestination places a relative file destination, such as file://name.iso, under the
+// category's destination directory, with its placeholders filled in for the item being
+// downloaded from src at now.  Other destinations, and those of categories without a
+// directory, are returned unchanged.
+func categoryDestination(category *queue.CategoryConfig, src, dst string, now time.Time) string {
+	template := category.GetDestinationTemplate()
+	rest, ok := strings.CutPrefix(dst, fileScheme)
+	if template == "" || !ok || strings.HasPrefix(rest, "/") {
+		return dst
+	}
+	var host string
+	if u, err := url.Parse(src); err == nil {
+		host = u.Hostname()
+	}
+	dir := strings.NewReplacer(
+		"{category}", category.GetId().GetId(),
+		"{host}", host,
+		"{date}", now.Format(time.DateOnly),
+	).Replace(template)
+	return fileScheme + strings.TrimPrefix(path.Join(dir, rest), "/")
+}
@@ -0,0 +1,102 @@
+package downloader
+
+import (
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/downloader/reader"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCheckAllowedHost(t *testing.T) {
+	category := &queue.CategoryConfig{
+		Id:           &queue.Identifier{Id: "iso"},
+		AllowedHosts: []string{"releases.example.com", "*.mirror.org"},
+	}
+	tests := []struct {
+		src     string
+		allowed bool
+	}{
+		{"https://releases.example.com/a.iso", true},
+		{"https://RELEASES.example.com:8443/a.iso", true},
+		{"https://example.com/a.iso", false},
+		{"https://mirror.org/a.iso", true},
+		{"https://eu.mirror.org/a.iso", true},
+		{"https://notmirror.org/a.iso", false},
+	}
+	for _, tt := range tests {
+		err := checkAllowedHost(category, tt.src)
+		if (err == nil) != tt.allowed {
+			t.Errorf("%v: expected allowed to be %v, got %v", tt.src, tt.allowed, err)
+		}
+		if err != nil && failureClass(err) != queue.FailureClass_FAILURE_CLASS_INVALID {
+			t.Errorf("%v: expected the refusal to be classed as invalid, got %v", tt.src, failureClass(err))
+		}
+	}
+	if err := checkAllowedHost(nil, "https://example.com/a.iso"); err != nil {
+		t.Errorf("expected items without a category to be allowed, got %v", err)
+	}
+}
+
+func TestRestrictRedirects(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/file", func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "content")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+	mux.Handle("/same-host", http.RedirectHandler(srv.URL+"/file", http.StatusFound))
+	mux.Handle("/other-host", http.RedirectHandler("http://localhost:"+u.Port()+"/file", http.StatusFound))
+	category := &queue.CategoryConfig{
+		Id:           &queue.Identifier{Id: "iso"},
+		AllowedHosts: []string{u.Hostname()},
+	}
+
+	open := func(path string) error {
+		rdr, err := reader.BuildFromURL(srv.URL + path)
+		if err != nil {
+			t.Fatalf("unable to build reader: %v", err)
+		}
+		restrictRedirects(category, rdr)
+		r, _, err := rdr.OpenReadCloser()
+		if err == nil {
+			r.Close()
+		}
+		return err
+	}
+	if err := open("/same-host"); err != nil {
+		t.Errorf("expected a redirect to an allowed host to be followed, got %v", err)
+	}
+	err := open("/other-host")
+	if err == nil || failureClass(err) != queue.FailureClass_FAILURE_CLASS_INVALID {
+		t.Errorf("expected a redirect to another host to be refused as invalid, got %v", err)
+	}
+}
+
+func TestCategoryDestination(t *testing.T) {
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	category := &queue.CategoryConfig{
+		Id:                  &queue.Identifier{Id: "iso"},
+		DestinationTemplate: "{category}/{host}/{date}",
+	}
+	tests := []struct {
+		category *queue.CategoryConfig
+		dst      string
+		want     string
+	}{
+		{category, "file://debian.iso", "file://iso/example.com/2024-03-05/debian.iso"},
+		{category, "file://images/debian.iso", "file://iso/example.com/2024-03-05/images/debian.iso"},
+		{category, "file:///srv/debian.iso", "file:///srv/debian.iso"},
+		{nil, "file://debian.iso", "file://debian.iso"},
+		{&queue.CategoryConfig{Id: &queue.Identifier{Id: "iso"}}, "file://debian.iso", "file://debian.iso"},
+	}
+	for _, tt := range tests {
+		if got := categoryDestination(tt.category, "https://example.com:8443/debian.iso", tt.dst, now); got != tt.want {
+			t.Errorf("%v: expected %v, got %v", tt.dst, tt.want, got)
+		}
+	}
+}
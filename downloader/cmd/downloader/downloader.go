@@ -2,23 +2,33 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/harryrose/godm/downloader"
 	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/downloader/reader"
 	"github.com/harryrose/godm/downloader/size"
 	"github.com/harryrose/godm/downloader/writer"
 	"github.com/harryrose/godm/log"
 	"github.com/harryrose/godm/log/keys"
 	"github.com/harryrose/godm/log/levels"
+	"github.com/harryrose/godm/log/tracing"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"net"
+	"net/http"
 	"os"
 	"strings"
 	"time"
 )
 
+// version is reported to the queue service when the downloader registers.  It can be set at
+// build time with -ldflags "-X main.version=...".
+var version = "dev"
+
 const (
 	defaultQueue            = "default"
 	defaultPollPeriod       = 10 * time.Second
@@ -34,6 +44,14 @@ const (
 	EnvPollPeriod         = "GODM_D_POLL_PERIOD"
 	EnvRateLimit          = "GODM_D_RATE_LIMIT"
 	EnvQueue              = "GODM_D_QUEUE"
+	EnvName               = "GODM_D_NAME"
+	EnvMetricsAddress     = "GODM_D_METRICS_ADDRESS"
+	EnvTLS                = "GODM_D_TLS"
+	EnvTLSCA              = "GODM_D_TLS_CA"
+	EnvTLSCert            = "GODM_D_TLS_CERT"
+	EnvTLSKey             = "GODM_D_TLS_KEY"
+	EnvTraceExporter      = "GODM_D_TRACE_EXPORTER"
+	EnvTraceEndpoint      = "GODM_D_TRACE_ENDPOINT"
 	FlagQueueAddress      = "queue-address"
 	FlagConnectionTimeout = "connection-timeout"
 	FlagDownloadDirectory = "download-directory"
@@ -42,6 +60,14 @@ const (
 	FlagPollPeriod        = "poll-period"
 	FlagRateLimit         = "rate-limit"
 	FlagQueue             = "queue"
+	FlagName              = "name"
+	FlagMetricsAddress    = "metrics-address"
+	FlagTLS               = "tls"
+	FlagTLSCA             = "tls-ca"
+	FlagTLSCert           = "tls-cert"
+	FlagTLSKey            = "tls-key"
+	FlagTraceExporter     = "trace-exporter"
+	FlagTraceEndpoint     = "trace-endpoint"
 )
 
 func main() {
@@ -76,11 +102,10 @@ func main() {
 				Sources:  cli.NewValueSourceChain(cli.EnvVar(EnvDownloadDirectory)),
 			},
 			&cli.StringFlag{
-				Name:     FlagKey,
-				Aliases:  []string{"k"},
-				Usage:    "The API key to use when connecting to the queue service",
-				Required: true,
-				Sources:  cli.NewValueSourceChain(cli.EnvVar(EnvKey)),
+				Name:    FlagKey,
+				Aliases: []string{"k"},
+				Usage:   "The API key to use when connecting to the queue service. Not needed if a client certificate is given",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvKey)),
 			},
 			&cli.StringFlag{
 				Name:    FlagUserAgent,
@@ -99,7 +124,7 @@ func main() {
 			&size.Flag{
 				Name:    FlagRateLimit,
 				Aliases: []string{"r"},
-				Usage:   "The maximum download rate in bytes per second (0 for unlimited). K, M, G suffixes are supported",
+				Usage:   "The maximum download rate in bytes per second (0 for unlimited), used when the queue doesn't set one. K, M, G suffixes are supported",
 				Value:   size.Size(rateLimitBytesPerSecond),
 				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvRateLimit)),
 			},
@@ -110,6 +135,50 @@ func main() {
 				Value:   defaultQueue,
 				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvQueue)),
 			},
+			&cli.StringFlag{
+				Name:        FlagName,
+				Aliases:     []string{"n"},
+				Usage:       "The name the downloader registers with the queue service as",
+				DefaultText: "the host name",
+				Sources:     cli.NewValueSourceChain(cli.EnvVar(EnvName)),
+			},
+			&cli.StringFlag{
+				Name:    FlagMetricsAddress,
+				Usage:   "The address, such as :9020, to serve Prometheus metrics at /metrics on. If not provided, metrics are not served",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvMetricsAddress)),
+			},
+			&cli.BoolFlag{
+				Name:    FlagTLS,
+				Usage:   "Connect to the queue service with TLS, verifying its certificate against the system's CAs unless --" + FlagTLSCA + " is given. Implied by the other TLS flags",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLS)),
+			},
+			&cli.StringFlag{
+				Name:    FlagTLSCA,
+				Usage:   "The path to PEM CA certificates to verify the queue service's certificate with",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSCA)),
+			},
+			&cli.StringFlag{
+				Name:    FlagTLSCert,
+				Usage:   "The path to a PEM client certificate to present to the queue service",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSCert)),
+			},
+			&cli.StringFlag{
+				Name:    FlagTLSKey,
+				Usage:   "The path to the PEM private key of the client certificate",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSKey)),
+			},
+			&cli.StringFlag{
+				Name:    FlagTraceExporter,
+				Usage:   "Where to send traces: " + strings.Join(tracing.Exporters, ", "),
+				Value:   tracing.ExporterNone,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTraceExporter)),
+			},
+			&cli.StringFlag{
+				Name:        FlagTraceEndpoint,
+				Usage:       "The address or URL of the OTLP collector to send traces to",
+				DefaultText: "localhost:4317",
+				Sources:     cli.NewValueSourceChain(cli.EnvVar(EnvTraceEndpoint)),
+			},
 		},
 		Action: func(ctx context.Context, command *cli.Command) error {
 			downloadDir := command.String(FlagDownloadDirectory)
@@ -133,8 +202,12 @@ func main() {
 				return fmt.Errorf("queue address cannot be empty")
 			}
 			key := command.String(FlagKey)
-			if key == "" {
-				return fmt.Errorf("key cannot be empty")
+			if key == "" && command.String(FlagTLSCert) == "" {
+				return fmt.Errorf("a key or a client certificate is required")
+			}
+			creds, err := transportCredentials(command)
+			if err != nil {
+				return err
 			}
 
 			pollPeriod := command.Duration(FlagPollPeriod)
@@ -155,11 +228,28 @@ func main() {
 				return fmt.Errorf("rate limit cannot be negative")
 			}
 
+			shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+				Exporter: command.String(FlagTraceExporter),
+				Endpoint: command.String(FlagTraceEndpoint),
+				Service:  "downloader",
+				Version:  version,
+			})
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					log.Warnw("unable to flush traces", keys.Error, err)
+				}
+			}()
+
 			conn, err := grpc.Dial(
 				queueAddress,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-				grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-					ctx = metadata.AppendToOutgoingContext(ctx, "authorization", key)
+				grpc.WithTransportCredentials(creds),
+				grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+					if key != "" {
+						ctx = metadata.AppendToOutgoingContext(ctx, "authorization", key)
+					}
 					return invoker(ctx, method, req, reply, cc, opts...)
 				}),
 			)
@@ -175,7 +265,44 @@ func main() {
 				os.Exit(1)
 			}
 
-			downloader.Run(context.Background(), client, pollPeriod, queueName, int(rateLimit.Bytes()))
+			host, err := os.Hostname()
+			if err != nil {
+				log.Warnw("unable to determine host name", keys.Error, err)
+			}
+			name := command.String(FlagName)
+			if strings.TrimSpace(name) == "" {
+				name = host
+			}
+			worker := &downloader.Worker{
+				Client: client,
+				Info: &queue.RegisterWorkerInput{
+					Name:                    name,
+					Host:                    host,
+					Version:                 version,
+					Schemes:                 reader.Schemes(),
+					RateLimitBytesPerSecond: uint64(rateLimit.Bytes()),
+				},
+			}
+			worker.Start(ctx)
+
+			if address := command.String(FlagMetricsAddress); address != "" {
+				listener, err := net.Listen("tcp", address)
+				if err != nil {
+					return fmt.Errorf("unable to listen for metrics: %w", err)
+				}
+				mux := http.NewServeMux()
+				mux.Handle("/metrics", promhttp.Handler())
+				metricsServer := &http.Server{Handler: mux}
+				log.Infow("serving metrics", keys.Address, listener.Addr().String())
+				go func() {
+					if err := metricsServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+						log.Errorw("error serving metrics", keys.Error, err)
+					}
+				}()
+				defer metricsServer.Close()
+			}
+
+			downloader.Run(ctx, client, worker, pollPeriod, queueName, int(rateLimit.Bytes()))
 			return nil
 		},
 	}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/harryrose/godm/log/clienttls"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/credentials"
+)
+
+// transportCredentials returns the credentials to connect to the queue service with.  The
+// connection is encrypted if --tls is set, or if any of the TLS files are given.  A client
+// certificate is presented if one is given, which the queue service may accept in place of a key.
+func transportCredentials(command *cli.Command) (credentials.TransportCredentials, error) {
+	return clienttls.Credentials(command.Bool(FlagTLS), command.String(FlagTLSCA), command.String(FlagTLSCert), command.String(FlagTLSKey))
+}
@@ -0,0 +1,60 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/downloader/reader"
+	"net/http"
+)
+
+// failure is an error which has been classified, so that the queue service can decide whether
+// the item should be retried.
+type failure struct {
+	class queue.FailureClass
+	err   error
+}
+
+func (f failure) Error() string {
+	return f.err.Error()
+}
+
+func (f failure) Unwrap() error {
+	return f.err
+}
+
+func fail(class queue.FailureClass, err error) error {
+	return failure{class: class, err: err}
+}
+
+// failureClass returns the class of failure that caused err.
+func failureClass(err error) queue.FailureClass {
+	var f failure
+	if errors.As(err, &f) {
+		return f.class
+	}
+
+	var status reader.StatusError
+	switch {
+	case errors.As(err, &status):
+		switch {
+		case status.StatusCode == http.StatusNotFound || status.StatusCode == http.StatusGone:
+			return queue.FailureClass_FAILURE_CLASS_NOT_FOUND
+		case status.StatusCode == http.StatusRequestTimeout || status.StatusCode == http.StatusTooManyRequests:
+			return queue.FailureClass_FAILURE_CLASS_SERVER
+		case status.StatusCode >= 500:
+			return queue.FailureClass_FAILURE_CLASS_SERVER
+		default:
+			return queue.FailureClass_FAILURE_CLASS_CLIENT
+		}
+
+	case errors.As(err, &WriteError{}):
+		return queue.FailureClass_FAILURE_CLASS_LOCAL
+
+	case errors.As(err, &ReadError{}), errors.Is(err, context.DeadlineExceeded):
+		return queue.FailureClass_FAILURE_CLASS_NETWORK
+
+	default:
+		return queue.FailureClass_FAILURE_CLASS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,32 @@
+package downloader
+
+import (
+	"errors"
+	"fmt"
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/downloader/reader"
+	"io"
+	"testing"
+)
+
+func TestFailureClass(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want queue.FailureClass
+	}{
+		{"not found", reader.StatusError{StatusCode: 404}, queue.FailureClass_FAILURE_CLASS_NOT_FOUND},
+		{"forbidden", reader.StatusError{StatusCode: 403}, queue.FailureClass_FAILURE_CLASS_CLIENT},
+		{"too many requests", reader.StatusError{StatusCode: 429}, queue.FailureClass_FAILURE_CLASS_SERVER},
+		{"bad gateway", fmt.Errorf("wrapped: %w", reader.StatusError{StatusCode: 502}), queue.FailureClass_FAILURE_CLASS_SERVER},
+		{"read", fmt.Errorf("transfer error: %w", ReadError{Err: io.ErrUnexpectedEOF}), queue.FailureClass_FAILURE_CLASS_NETWORK},
+		{"write", fmt.Errorf("transfer error: %w", WriteError{Err: io.ErrShortWrite}), queue.FailureClass_FAILURE_CLASS_LOCAL},
+		{"classified", fail(queue.FailureClass_FAILURE_CLASS_INVALID, errors.New("bad scheme")), queue.FailureClass_FAILURE_CLASS_INVALID},
+		{"unknown", errors.New("something"), queue.FailureClass_FAILURE_CLASS_UNSPECIFIED},
+	}
+	for _, tt := range tests {
+		if got := failureClass(tt.err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
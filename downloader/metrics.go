@@ -0,0 +1,67 @@
+package downloader
+
+import (
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"strings"
+)
+
+// Results of polling the queue, as reported by polls.
+const (
+	pollClaimed = "claimed"
+	pollEmpty   = "empty"
+	pollError   = "error"
+)
+
+// Results of downloads, as reported by itemDuration.
+const (
+	resultComplete  = "complete"
+	resultFailed    = "failed"
+	resultAbandoned = "abandoned"
+)
+
+var (
+	bytesTransferred = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "bytes_transferred_total",
+		Help:      "The number of bytes written to destinations.",
+	})
+	throughput = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "throughput_bytes_per_second",
+		Help:      "The rate at which the current item has been downloaded since its progress was last reported, or zero if nothing is downloading.",
+	})
+	rateLimitWait = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "rate_limit_wait_seconds_total",
+		Help:      "The time spent waiting for the rate limiter.",
+	})
+	itemDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "item_duration_seconds",
+		Help:      "The time taken to download items, by result.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	}, []string{"result"})
+	failures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "failures_total",
+		Help:      "The number of failed downloads, by the class of failure.",
+	}, []string{"class"})
+	polls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "downloader",
+		Name:      "polls_total",
+		Help:      "The number of times the queue was polled for an item, by result.",
+	}, []string{"result"})
+)
+
+// failureLabel returns the name of the failure class as it is labelled, such as "network".
+func failureLabel(class queue.FailureClass) string {
+	return strings.ToLower(strings.TrimPrefix(class.String(), "FAILURE_CLASS_"))
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.2.0
-// - protoc             v4.24.3
+// - protoc             v5.29.3
 // source: queue-service.proto
 
 package queue
@@ -24,7 +24,22 @@ const _ = grpc.SupportPackageIsVersion7
 type QueueServiceClient interface {
 	// CreateQueue constructs and stores a new queue with the specified parameters.
 	CreateQueue(ctx context.Context, in *CreateQueueInput, opts ...grpc.CallOption) (*CreateQueueResult, error)
-	// EnqueueItem places the specified item at the end of the queue.
+	// ListQueues returns the list of all known queues.
+	ListQueues(ctx context.Context, in *ListQueuesInput, opts ...grpc.CallOption) (*ListQueuesResult, error)
+	// DeleteQueue removes a queue, with its active items and history.  The queue can be
+	// archived first, to a file which the service's import command can restore it from.
+	DeleteQueue(ctx context.Context, in *DeleteQueueInput, opts ...grpc.CallOption) (*DeleteQueueResult, error)
+	// RenameQueue changes the name a queue is displayed with.  Its id, which callers and its
+	// items' ids refer to it by, doesn't change.
+	RenameQueue(ctx context.Context, in *RenameQueueInput, opts ...grpc.CallOption) (*RenameQueueResult, error)
+	// PauseQueue stops a queue's items from being claimed until ResumeQueue is called.
+	// Items can still be enqueued, and those already claimed carry on downloading.
+	PauseQueue(ctx context.Context, in *PauseQueueInput, opts ...grpc.CallOption) (*PauseQueueResult, error)
+	// ResumeQueue lets a paused queue's items be claimed again.
+	ResumeQueue(ctx context.Context, in *ResumeQueueInput, opts ...grpc.CallOption) (*ResumeQueueResult, error)
+	// EnqueueItem places the specified item at the end of the queue.  Callers can choose to
+	// have an item which duplicates one already in the queue rejected, or to be given the
+	// existing item instead.
 	EnqueueItem(ctx context.Context, in *EnqueueItemInput, opts ...grpc.CallOption) (*EnqueueItemResult, error)
 	// CancelItem dequeues the specified item.
 	CancelItem(ctx context.Context, in *CancelItemInput, opts ...grpc.CallOption) (*CancelItemResult, error)
@@ -32,14 +47,78 @@ type QueueServiceClient interface {
 	GetQueueItems(ctx context.Context, in *GetQueueItemsInput, opts ...grpc.CallOption) (*GetQueueItemsResult, error)
 	// GetFinishedItems returns the list of items that have been downloaded
 	GetFinishedItems(ctx context.Context, in *GetFinishedItemsInput, opts ...grpc.CallOption) (*GetFinishedItemsResult, error)
-	// SetItemState updates the current state of the specified item.
+	// SetItemState updates the current state of the specified item.  Only the holder of
+	// the item's current claim can update it.
 	SetItemState(ctx context.Context, in *SetItemStateInput, opts ...grpc.CallOption) (*SetItemStateResult, error)
 	// ClaimItemState gets the next queued item and also sets its state to Claimed.
 	// The claimed state has a finite TTL, so the state should be set using SetItemState
 	// as soon as the caller starts working on the item.
 	ClaimNextItem(ctx context.Context, in *ClaimNextItemInput, opts ...grpc.CallOption) (*ClaimNextItemResult, error)
-	// ClearHistory removes all finished items.  It does not affect the active queue.
+	// ClearHistory removes finished items, either all of them or those matching a filter.  It
+	// does not affect the active queue.
 	ClearHistory(ctx context.Context, in *ClearHistoryInput, opts ...grpc.CallOption) (*ClearHistoryResult, error)
+	// RequeueItems enqueues copies of finished items, chosen by id or by a filter, at the back
+	// of their queue.  The history is left as it is.  A copy of an item which failed part way
+	// through can carry on from where it stopped, if its source and destination allow it.
+	RequeueItems(ctx context.Context, in *RequeueItemsInput, opts ...grpc.CallOption) (*RequeueItemsResult, error)
+	// WatchQueue streams changes to the items in a queue as they happen.  The stream
+	// begins with a snapshot of the queue's current items, unless the caller is resuming
+	// from a sequence number whose subsequent events are still held by the service.
+	WatchQueue(ctx context.Context, in *WatchQueueInput, opts ...grpc.CallOption) (QueueService_WatchQueueClient, error)
+	// MoveItem places an item immediately before or after another item in the same queue.
+	// The moved item takes on the priority of the item it is placed next to.
+	MoveItem(ctx context.Context, in *MoveItemInput, opts ...grpc.CallOption) (*MoveItemResult, error)
+	// MoveToFront places an item at the front of its queue, raising its priority to that of
+	// the highest priority item in the queue if necessary.
+	MoveToFront(ctx context.Context, in *MoveToFrontInput, opts ...grpc.CallOption) (*MoveToFrontResult, error)
+	// MoveToBack places an item at the back of its queue, lowering its priority to that of
+	// the lowest priority item in the queue if necessary.
+	MoveToBack(ctx context.Context, in *MoveToBackInput, opts ...grpc.CallOption) (*MoveToBackResult, error)
+	// UpdateItem changes the source, destination or category of a queued item, without
+	// changing its position in the queue.  An item which is being downloaded can't be
+	// changed, except to set the source its next attempt is downloaded from.
+	UpdateItem(ctx context.Context, in *UpdateItemInput, opts ...grpc.CallOption) (*UpdateItemResult, error)
+	// SetQueueRetryPolicy sets the retry policy applied to the queue's items which don't
+	// specify their own.
+	SetQueueRetryPolicy(ctx context.Context, in *SetQueueRetryPolicyInput, opts ...grpc.CallOption) (*SetQueueRetryPolicyResult, error)
+	// GetQueueConfig returns the settings of a queue.
+	GetQueueConfig(ctx context.Context, in *GetQueueConfigInput, opts ...grpc.CallOption) (*GetQueueConfigResult, error)
+	// UpdateQueueConfig changes the settings of a queue.
+	UpdateQueueConfig(ctx context.Context, in *UpdateQueueConfigInput, opts ...grpc.CallOption) (*UpdateQueueConfigResult, error)
+	// RegisterWorker announces a downloader to the service.  The returned worker id should
+	// be passed to ClaimNextItem and Heartbeat.
+	RegisterWorker(ctx context.Context, in *RegisterWorkerInput, opts ...grpc.CallOption) (*RegisterWorkerResult, error)
+	// Heartbeat tells the service that a registered downloader is still alive.  Workers
+	// which don't send a heartbeat within the interval returned by RegisterWorker expire, and
+	// must register again.
+	Heartbeat(ctx context.Context, in *HeartbeatInput, opts ...grpc.CallOption) (*HeartbeatResult, error)
+	// ListWorkers returns the downloaders which are currently registered.
+	ListWorkers(ctx context.Context, in *ListWorkersInput, opts ...grpc.CallOption) (*ListWorkersResult, error)
+	// Backup streams a consistent copy of the service's database, which is taken while
+	// the service carries on running.
+	Backup(ctx context.Context, in *BackupInput, opts ...grpc.CallOption) (QueueService_BackupClient, error)
+	// CreateKey creates an API key with the given role.  The key's secret is only
+	// returned here, and can't be recovered later.
+	CreateKey(ctx context.Context, in *CreateKeyInput, opts ...grpc.CallOption) (*CreateKeyResult, error)
+	// RevokeKey deletes an API key, so that its secret no longer works.
+	RevokeKey(ctx context.Context, in *RevokeKeyInput, opts ...grpc.CallOption) (*RevokeKeyResult, error)
+	// RotateKey replaces the secret of an API key.  The old secret stops working straight
+	// away.
+	RotateKey(ctx context.Context, in *RotateKeyInput, opts ...grpc.CallOption) (*RotateKeyResult, error)
+	// ListKeys returns the API keys, without their secrets.
+	ListKeys(ctx context.Context, in *ListKeysInput, opts ...grpc.CallOption) (*ListKeysResult, error)
+	// CreateCategory defines the settings of a category, which apply to its items in every
+	// queue.  Items can be given categories which haven't been created, but they have no
+	// settings.
+	CreateCategory(ctx context.Context, in *CreateCategoryInput, opts ...grpc.CallOption) (*CreateCategoryResult, error)
+	// ListCategories returns the categories which have been created.
+	ListCategories(ctx context.Context, in *ListCategoriesInput, opts ...grpc.CallOption) (*ListCategoriesResult, error)
+	// UpdateCategory changes the settings of a category.  Items which are already being
+	// downloaded keep the settings they were claimed with.
+	UpdateCategory(ctx context.Context, in *UpdateCategoryInput, opts ...grpc.CallOption) (*UpdateCategoryResult, error)
+	// DeleteCategory removes a category's settings.  Its items keep the category, but no
+	// longer have its settings applied.
+	DeleteCategory(ctx context.Context, in *DeleteCategoryInput, opts ...grpc.CallOption) (*DeleteCategoryResult, error)
 }
 
 type queueServiceClient struct {
@@ -59,6 +138,51 @@ func (c *queueServiceClient) CreateQueue(ctx context.Context, in *CreateQueueInp
 	return out, nil
 }
 
+func (c *queueServiceClient) ListQueues(ctx context.Context, in *ListQueuesInput, opts ...grpc.CallOption) (*ListQueuesResult, error) {
+	out := new(ListQueuesResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/ListQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) DeleteQueue(ctx context.Context, in *DeleteQueueInput, opts ...grpc.CallOption) (*DeleteQueueResult, error) {
+	out := new(DeleteQueueResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/DeleteQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) RenameQueue(ctx context.Context, in *RenameQueueInput, opts ...grpc.CallOption) (*RenameQueueResult, error) {
+	out := new(RenameQueueResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/RenameQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) PauseQueue(ctx context.Context, in *PauseQueueInput, opts ...grpc.CallOption) (*PauseQueueResult, error) {
+	out := new(PauseQueueResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/PauseQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) ResumeQueue(ctx context.Context, in *ResumeQueueInput, opts ...grpc.CallOption) (*ResumeQueueResult, error) {
+	out := new(ResumeQueueResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/ResumeQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queueServiceClient) EnqueueItem(ctx context.Context, in *EnqueueItemInput, opts ...grpc.CallOption) (*EnqueueItemResult, error) {
 	out := new(EnqueueItemResult)
 	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/EnqueueItem", in, out, opts...)
@@ -122,13 +246,263 @@ func (c *queueServiceClient) ClearHistory(ctx context.Context, in *ClearHistoryI
 	return out, nil
 }
 
+func (c *queueServiceClient) RequeueItems(ctx context.Context, in *RequeueItemsInput, opts ...grpc.CallOption) (*RequeueItemsResult, error) {
+	out := new(RequeueItemsResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/RequeueItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) WatchQueue(ctx context.Context, in *WatchQueueInput, opts ...grpc.CallOption) (QueueService_WatchQueueClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QueueService_ServiceDesc.Streams[0], "/queue_svc.QueueService/WatchQueue", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueServiceWatchQueueClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueueService_WatchQueueClient interface {
+	Recv() (*QueueEvent, error)
+	grpc.ClientStream
+}
+
+type queueServiceWatchQueueClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueServiceWatchQueueClient) Recv() (*QueueEvent, error) {
+	m := new(QueueEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queueServiceClient) MoveItem(ctx context.Context, in *MoveItemInput, opts ...grpc.CallOption) (*MoveItemResult, error) {
+	out := new(MoveItemResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/MoveItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) MoveToFront(ctx context.Context, in *MoveToFrontInput, opts ...grpc.CallOption) (*MoveToFrontResult, error) {
+	out := new(MoveToFrontResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/MoveToFront", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) MoveToBack(ctx context.Context, in *MoveToBackInput, opts ...grpc.CallOption) (*MoveToBackResult, error) {
+	out := new(MoveToBackResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/MoveToBack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) UpdateItem(ctx context.Context, in *UpdateItemInput, opts ...grpc.CallOption) (*UpdateItemResult, error) {
+	out := new(UpdateItemResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/UpdateItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) SetQueueRetryPolicy(ctx context.Context, in *SetQueueRetryPolicyInput, opts ...grpc.CallOption) (*SetQueueRetryPolicyResult, error) {
+	out := new(SetQueueRetryPolicyResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/SetQueueRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) GetQueueConfig(ctx context.Context, in *GetQueueConfigInput, opts ...grpc.CallOption) (*GetQueueConfigResult, error) {
+	out := new(GetQueueConfigResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/GetQueueConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) UpdateQueueConfig(ctx context.Context, in *UpdateQueueConfigInput, opts ...grpc.CallOption) (*UpdateQueueConfigResult, error) {
+	out := new(UpdateQueueConfigResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/UpdateQueueConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) RegisterWorker(ctx context.Context, in *RegisterWorkerInput, opts ...grpc.CallOption) (*RegisterWorkerResult, error) {
+	out := new(RegisterWorkerResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/RegisterWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) Heartbeat(ctx context.Context, in *HeartbeatInput, opts ...grpc.CallOption) (*HeartbeatResult, error) {
+	out := new(HeartbeatResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) ListWorkers(ctx context.Context, in *ListWorkersInput, opts ...grpc.CallOption) (*ListWorkersResult, error) {
+	out := new(ListWorkersResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/ListWorkers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) Backup(ctx context.Context, in *BackupInput, opts ...grpc.CallOption) (QueueService_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QueueService_ServiceDesc.Streams[1], "/queue_svc.QueueService/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queueServiceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type QueueService_BackupClient interface {
+	Recv() (*BackupChunk, error)
+	grpc.ClientStream
+}
+
+type queueServiceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *queueServiceBackupClient) Recv() (*BackupChunk, error) {
+	m := new(BackupChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queueServiceClient) CreateKey(ctx context.Context, in *CreateKeyInput, opts ...grpc.CallOption) (*CreateKeyResult, error) {
+	out := new(CreateKeyResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/CreateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) RevokeKey(ctx context.Context, in *RevokeKeyInput, opts ...grpc.CallOption) (*RevokeKeyResult, error) {
+	out := new(RevokeKeyResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/RevokeKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) RotateKey(ctx context.Context, in *RotateKeyInput, opts ...grpc.CallOption) (*RotateKeyResult, error) {
+	out := new(RotateKeyResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/RotateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) ListKeys(ctx context.Context, in *ListKeysInput, opts ...grpc.CallOption) (*ListKeysResult, error) {
+	out := new(ListKeysResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryInput, opts ...grpc.CallOption) (*CreateCategoryResult, error) {
+	out := new(CreateCategoryResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) ListCategories(ctx context.Context, in *ListCategoriesInput, opts ...grpc.CallOption) (*ListCategoriesResult, error) {
+	out := new(ListCategoriesResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryInput, opts ...grpc.CallOption) (*UpdateCategoryResult, error) {
+	out := new(UpdateCategoryResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryInput, opts ...grpc.CallOption) (*DeleteCategoryResult, error) {
+	out := new(DeleteCategoryResult)
+	err := c.cc.Invoke(ctx, "/queue_svc.QueueService/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServiceServer is the server API for QueueService service.
 // All implementations must embed UnimplementedQueueServiceServer
 // for forward compatibility
 type QueueServiceServer interface {
 	// CreateQueue constructs and stores a new queue with the specified parameters.
 	CreateQueue(context.Context, *CreateQueueInput) (*CreateQueueResult, error)
-	// EnqueueItem places the specified item at the end of the queue.
+	// ListQueues returns the list of all known queues.
+	ListQueues(context.Context, *ListQueuesInput) (*ListQueuesResult, error)
+	// DeleteQueue removes a queue, with its active items and history.  The queue can be
+	// archived first, to a file which the service's import command can restore it from.
+	DeleteQueue(context.Context, *DeleteQueueInput) (*DeleteQueueResult, error)
+	// RenameQueue changes the name a queue is displayed with.  Its id, which callers and its
+	// items' ids refer to it by, doesn't change.
+	RenameQueue(context.Context, *RenameQueueInput) (*RenameQueueResult, error)
+	// PauseQueue stops a queue's items from being claimed until ResumeQueue is called.
+	// Items can still be enqueued, and those already claimed carry on downloading.
+	PauseQueue(context.Context, *PauseQueueInput) (*PauseQueueResult, error)
+	// ResumeQueue lets a paused queue's items be claimed again.
+	ResumeQueue(context.Context, *ResumeQueueInput) (*ResumeQueueResult, error)
+	// EnqueueItem places the specified item at the end of the queue.  Callers can choose to
+	// have an item which duplicates one already in the queue rejected, or to be given the
+	// existing item instead.
 	EnqueueItem(context.Context, *EnqueueItemInput) (*EnqueueItemResult, error)
 	// CancelItem dequeues the specified item.
 	CancelItem(context.Context, *CancelItemInput) (*CancelItemResult, error)
@@ -136,14 +510,78 @@ type QueueServiceServer interface {
 	GetQueueItems(context.Context, *GetQueueItemsInput) (*GetQueueItemsResult, error)
 	// GetFinishedItems returns the list of items that have been downloaded
 	GetFinishedItems(context.Context, *GetFinishedItemsInput) (*GetFinishedItemsResult, error)
-	// SetItemState updates the current state of the specified item.
+	// SetItemState updates the current state of the specified item.  Only the holder of
+	// the item's current claim can update it.
 	SetItemState(context.Context, *SetItemStateInput) (*SetItemStateResult, error)
 	// ClaimItemState gets the next queued item and also sets its state to Claimed.
 	// The claimed state has a finite TTL, so the state should be set using SetItemState
 	// as soon as the caller starts working on the item.
 	ClaimNextItem(context.Context, *ClaimNextItemInput) (*ClaimNextItemResult, error)
-	// ClearHistory removes all finished items.  It does not affect the active queue.
+	// ClearHistory removes finished items, either all of them or those matching a filter.  It
+	// does not affect the active queue.
 	ClearHistory(context.Context, *ClearHistoryInput) (*ClearHistoryResult, error)
+	// RequeueItems enqueues copies of finished items, chosen by id or by a filter, at the back
+	// of their queue.  The history is left as it is.  A copy of an item which failed part way
+	// through can carry on from where it stopped, if its source and destination allow it.
+	RequeueItems(context.Context, *RequeueItemsInput) (*RequeueItemsResult, error)
+	// WatchQueue streams changes to the items in a queue as they happen.  The stream
+	// begins with a snapshot of the queue's current items, unless the caller is resuming
+	// from a sequence number whose subsequent events are still held by the service.
+	WatchQueue(*WatchQueueInput, QueueService_WatchQueueServer) error
+	// MoveItem places an item immediately before or after another item in the same queue.
+	// The moved item takes on the priority of the item it is placed next to.
+	MoveItem(context.Context, *MoveItemInput) (*MoveItemResult, error)
+	// MoveToFront places an item at the front of its queue, raising its priority to that of
+	// the highest priority item in the queue if necessary.
+	MoveToFront(context.Context, *MoveToFrontInput) (*MoveToFrontResult, error)
+	// MoveToBack places an item at the back of its queue, lowering its priority to that of
+	// the lowest priority item in the queue if necessary.
+	MoveToBack(context.Context, *MoveToBackInput) (*MoveToBackResult, error)
+	// UpdateItem changes the source, destination or category of a queued item, without
+	// changing its position in the queue.  An item which is being downloaded can't be
+	// changed, except to set the source its next attempt is downloaded from.
+	UpdateItem(context.Context, *UpdateItemInput) (*UpdateItemResult, error)
+	// SetQueueRetryPolicy sets the retry policy applied to the queue's items which don't
+	// specify their own.
+	SetQueueRetryPolicy(context.Context, *SetQueueRetryPolicyInput) (*SetQueueRetryPolicyResult, error)
+	// GetQueueConfig returns the settings of a queue.
+	GetQueueConfig(context.Context, *GetQueueConfigInput) (*GetQueueConfigResult, error)
+	// UpdateQueueConfig changes the settings of a queue.
+	UpdateQueueConfig(context.Context, *UpdateQueueConfigInput) (*UpdateQueueConfigResult, error)
+	// RegisterWorker announces a downloader to the service.  The returned worker id should
+	// be passed to ClaimNextItem and Heartbeat.
+	RegisterWorker(context.Context, *RegisterWorkerInput) (*RegisterWorkerResult, error)
+	// Heartbeat tells the service that a registered downloader is still alive.  Workers
+	// which don't send a heartbeat within the interval returned by RegisterWorker expire, and
+	// must register again.
+	Heartbeat(context.Context, *HeartbeatInput) (*HeartbeatResult, error)
+	// ListWorkers returns the downloaders which are currently registered.
+	ListWorkers(context.Context, *ListWorkersInput) (*ListWorkersResult, error)
+	// Backup streams a consistent copy of the service's database, which is taken while
+	// the service carries on running.
+	Backup(*BackupInput, QueueService_BackupServer) error
+	// CreateKey creates an API key with the given role.  The key's secret is only
+	// returned here, and can't be recovered later.
+	CreateKey(context.Context, *CreateKeyInput) (*CreateKeyResult, error)
+	// RevokeKey deletes an API key, so that its secret no longer works.
+	RevokeKey(context.Context, *RevokeKeyInput) (*RevokeKeyResult, error)
+	// RotateKey replaces the secret of an API key.  The old secret stops working straight
+	// away.
+	RotateKey(context.Context, *RotateKeyInput) (*RotateKeyResult, error)
+	// ListKeys returns the API keys, without their secrets.
+	ListKeys(context.Context, *ListKeysInput) (*ListKeysResult, error)
+	// CreateCategory defines the settings of a category, which apply to its items in every
+	// queue.  Items can be given categories which haven't been created, but they have no
+	// settings.
+	CreateCategory(context.Context, *CreateCategoryInput) (*CreateCategoryResult, error)
+	// ListCategories returns the categories which have been created.
+	ListCategories(context.Context, *ListCategoriesInput) (*ListCategoriesResult, error)
+	// UpdateCategory changes the settings of a category.  Items which are already being
+	// downloaded keep the settings they were claimed with.
+	UpdateCategory(context.Context, *UpdateCategoryInput) (*UpdateCategoryResult, error)
+	// DeleteCategory removes a category's settings.  Its items keep the category, but no
+	// longer have its settings applied.
+	DeleteCategory(context.Context, *DeleteCategoryInput) (*DeleteCategoryResult, error)
 	mustEmbedUnimplementedQueueServiceServer()
 }
 
@@ -154,6 +592,21 @@ type UnimplementedQueueServiceServer struct {
 func (UnimplementedQueueServiceServer) CreateQueue(context.Context, *CreateQueueInput) (*CreateQueueResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
 }
+func (UnimplementedQueueServiceServer) ListQueues(context.Context, *ListQueuesInput) (*ListQueuesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
+}
+func (UnimplementedQueueServiceServer) DeleteQueue(context.Context, *DeleteQueueInput) (*DeleteQueueResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) RenameQueue(context.Context, *RenameQueueInput) (*RenameQueueResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) PauseQueue(context.Context, *PauseQueueInput) (*PauseQueueResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) ResumeQueue(context.Context, *ResumeQueueInput) (*ResumeQueueResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeQueue not implemented")
+}
 func (UnimplementedQueueServiceServer) EnqueueItem(context.Context, *EnqueueItemInput) (*EnqueueItemResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnqueueItem not implemented")
 }
@@ -175,6 +628,69 @@ func (UnimplementedQueueServiceServer) ClaimNextItem(context.Context, *ClaimNext
 func (UnimplementedQueueServiceServer) ClearHistory(context.Context, *ClearHistoryInput) (*ClearHistoryResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
 }
+func (UnimplementedQueueServiceServer) RequeueItems(context.Context, *RequeueItemsInput) (*RequeueItemsResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueItems not implemented")
+}
+func (UnimplementedQueueServiceServer) WatchQueue(*WatchQueueInput, QueueService_WatchQueueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQueue not implemented")
+}
+func (UnimplementedQueueServiceServer) MoveItem(context.Context, *MoveItemInput) (*MoveItemResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveItem not implemented")
+}
+func (UnimplementedQueueServiceServer) MoveToFront(context.Context, *MoveToFrontInput) (*MoveToFrontResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToFront not implemented")
+}
+func (UnimplementedQueueServiceServer) MoveToBack(context.Context, *MoveToBackInput) (*MoveToBackResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToBack not implemented")
+}
+func (UnimplementedQueueServiceServer) UpdateItem(context.Context, *UpdateItemInput) (*UpdateItemResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedQueueServiceServer) SetQueueRetryPolicy(context.Context, *SetQueueRetryPolicyInput) (*SetQueueRetryPolicyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueRetryPolicy not implemented")
+}
+func (UnimplementedQueueServiceServer) GetQueueConfig(context.Context, *GetQueueConfigInput) (*GetQueueConfigResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueConfig not implemented")
+}
+func (UnimplementedQueueServiceServer) UpdateQueueConfig(context.Context, *UpdateQueueConfigInput) (*UpdateQueueConfigResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueueConfig not implemented")
+}
+func (UnimplementedQueueServiceServer) RegisterWorker(context.Context, *RegisterWorkerInput) (*RegisterWorkerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWorker not implemented")
+}
+func (UnimplementedQueueServiceServer) Heartbeat(context.Context, *HeartbeatInput) (*HeartbeatResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedQueueServiceServer) ListWorkers(context.Context, *ListWorkersInput) (*ListWorkersResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
+}
+func (UnimplementedQueueServiceServer) Backup(*BackupInput, QueueService_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedQueueServiceServer) CreateKey(context.Context, *CreateKeyInput) (*CreateKeyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
+}
+func (UnimplementedQueueServiceServer) RevokeKey(context.Context, *RevokeKeyInput) (*RevokeKeyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKey not implemented")
+}
+func (UnimplementedQueueServiceServer) RotateKey(context.Context, *RotateKeyInput) (*RotateKeyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
+func (UnimplementedQueueServiceServer) ListKeys(context.Context, *ListKeysInput) (*ListKeysResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedQueueServiceServer) CreateCategory(context.Context, *CreateCategoryInput) (*CreateCategoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedQueueServiceServer) ListCategories(context.Context, *ListCategoriesInput) (*ListCategoriesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedQueueServiceServer) UpdateCategory(context.Context, *UpdateCategoryInput) (*UpdateCategoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedQueueServiceServer) DeleteCategory(context.Context, *DeleteCategoryInput) (*DeleteCategoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
 func (UnimplementedQueueServiceServer) mustEmbedUnimplementedQueueServiceServer() {}
 
 // UnsafeQueueServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -206,143 +722,637 @@ func _QueueService_CreateQueue_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_EnqueueItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnqueueItemInput)
+func _QueueService_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuesInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).EnqueueItem(ctx, in)
+		return srv.(QueueServiceServer).ListQueues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/EnqueueItem",
+		FullMethod: "/queue_svc.QueueService/ListQueues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).EnqueueItem(ctx, req.(*EnqueueItemInput))
+		return srv.(QueueServiceServer).ListQueues(ctx, req.(*ListQueuesInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_CancelItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelItemInput)
+func _QueueService_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQueueInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).CancelItem(ctx, in)
+		return srv.(QueueServiceServer).DeleteQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/CancelItem",
+		FullMethod: "/queue_svc.QueueService/DeleteQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).CancelItem(ctx, req.(*CancelItemInput))
+		return srv.(QueueServiceServer).DeleteQueue(ctx, req.(*DeleteQueueInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_GetQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQueueItemsInput)
+func _QueueService_RenameQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameQueueInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).GetQueueItems(ctx, in)
+		return srv.(QueueServiceServer).RenameQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/GetQueueItems",
+		FullMethod: "/queue_svc.QueueService/RenameQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).GetQueueItems(ctx, req.(*GetQueueItemsInput))
+		return srv.(QueueServiceServer).RenameQueue(ctx, req.(*RenameQueueInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_GetFinishedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFinishedItemsInput)
+func _QueueService_PauseQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseQueueInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).GetFinishedItems(ctx, in)
+		return srv.(QueueServiceServer).PauseQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/GetFinishedItems",
+		FullMethod: "/queue_svc.QueueService/PauseQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).GetFinishedItems(ctx, req.(*GetFinishedItemsInput))
+		return srv.(QueueServiceServer).PauseQueue(ctx, req.(*PauseQueueInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_SetItemState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetItemStateInput)
+func _QueueService_ResumeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeQueueInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).SetItemState(ctx, in)
+		return srv.(QueueServiceServer).ResumeQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/SetItemState",
+		FullMethod: "/queue_svc.QueueService/ResumeQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).SetItemState(ctx, req.(*SetItemStateInput))
+		return srv.(QueueServiceServer).ResumeQueue(ctx, req.(*ResumeQueueInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_ClaimNextItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimNextItemInput)
+func _QueueService_EnqueueItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueItemInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).ClaimNextItem(ctx, in)
+		return srv.(QueueServiceServer).EnqueueItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/ClaimNextItem",
+		FullMethod: "/queue_svc.QueueService/EnqueueItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).ClaimNextItem(ctx, req.(*ClaimNextItemInput))
+		return srv.(QueueServiceServer).EnqueueItem(ctx, req.(*EnqueueItemInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _QueueService_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearHistoryInput)
+func _QueueService_CancelItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelItemInput)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServiceServer).ClearHistory(ctx, in)
+		return srv.(QueueServiceServer).CancelItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/queue_svc.QueueService/ClearHistory",
+		FullMethod: "/queue_svc.QueueService/CancelItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).ClearHistory(ctx, req.(*ClearHistoryInput))
+		return srv.(QueueServiceServer).CancelItem(ctx, req.(*CancelItemInput))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// QueueService_ServiceDesc is the grpc.ServiceDesc for QueueService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var QueueService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "queue_svc.QueueService",
-	HandlerType: (*QueueServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _QueueService_GetQueueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueItemsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).GetQueueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/GetQueueItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).GetQueueItems(ctx, req.(*GetQueueItemsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_GetFinishedItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinishedItemsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).GetFinishedItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/GetFinishedItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).GetFinishedItems(ctx, req.(*GetFinishedItemsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_SetItemState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemStateInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).SetItemState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/SetItemState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).SetItemState(ctx, req.(*SetItemStateInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ClaimNextItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimNextItemInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ClaimNextItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/ClaimNextItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ClaimNextItem(ctx, req.(*ClaimNextItemInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearHistoryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ClearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/ClearHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ClearHistory(ctx, req.(*ClearHistoryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_RequeueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueItemsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).RequeueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/RequeueItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).RequeueItems(ctx, req.(*RequeueItemsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_WatchQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueueInput)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServiceServer).WatchQueue(m, &queueServiceWatchQueueServer{stream})
+}
+
+type QueueService_WatchQueueServer interface {
+	Send(*QueueEvent) error
+	grpc.ServerStream
+}
+
+type queueServiceWatchQueueServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueServiceWatchQueueServer) Send(m *QueueEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueueService_MoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveItemInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).MoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/MoveItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).MoveItem(ctx, req.(*MoveItemInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_MoveToFront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToFrontInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).MoveToFront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/MoveToFront",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).MoveToFront(ctx, req.(*MoveToFrontInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_MoveToBack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToBackInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).MoveToBack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/MoveToBack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).MoveToBack(ctx, req.(*MoveToBackInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/UpdateItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).UpdateItem(ctx, req.(*UpdateItemInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_SetQueueRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueRetryPolicyInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).SetQueueRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/SetQueueRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).SetQueueRetryPolicy(ctx, req.(*SetQueueRetryPolicyInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_GetQueueConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueConfigInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).GetQueueConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/GetQueueConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).GetQueueConfig(ctx, req.(*GetQueueConfigInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_UpdateQueueConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQueueConfigInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).UpdateQueueConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/UpdateQueueConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).UpdateQueueConfig(ctx, req.(*UpdateQueueConfigInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_RegisterWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWorkerInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).RegisterWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/RegisterWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).RegisterWorker(ctx, req.(*RegisterWorkerInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).Heartbeat(ctx, req.(*HeartbeatInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ListWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkersInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ListWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/ListWorkers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ListWorkers(ctx, req.(*ListWorkersInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupInput)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServiceServer).Backup(m, &queueServiceBackupServer{stream})
+}
+
+type QueueService_BackupServer interface {
+	Send(*BackupChunk) error
+	grpc.ServerStream
+}
+
+type queueServiceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueServiceBackupServer) Send(m *BackupChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueueService_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeyInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).CreateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/CreateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).CreateKey(ctx, req.(*CreateKeyInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_RevokeKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeKeyInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).RevokeKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/RevokeKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).RevokeKey(ctx, req.(*RevokeKeyInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_RotateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeyInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).RotateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/RotateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).RotateKey(ctx, req.(*RotateKeyInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ListKeys(ctx, req.(*ListKeysInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).CreateCategory(ctx, req.(*CreateCategoryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).ListCategories(ctx, req.(*ListCategoriesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueueService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queue_svc.QueueService/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QueueService_ServiceDesc is the grpc.ServiceDesc for QueueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QueueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "queue_svc.QueueService",
+	HandlerType: (*QueueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CreateQueue",
 			Handler:    _QueueService_CreateQueue_Handler,
 		},
+		{
+			MethodName: "ListQueues",
+			Handler:    _QueueService_ListQueues_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _QueueService_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "RenameQueue",
+			Handler:    _QueueService_RenameQueue_Handler,
+		},
+		{
+			MethodName: "PauseQueue",
+			Handler:    _QueueService_PauseQueue_Handler,
+		},
+		{
+			MethodName: "ResumeQueue",
+			Handler:    _QueueService_ResumeQueue_Handler,
+		},
 		{
 			MethodName: "EnqueueItem",
 			Handler:    _QueueService_EnqueueItem_Handler,
@@ -371,7 +1381,94 @@ var QueueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearHistory",
 			Handler:    _QueueService_ClearHistory_Handler,
 		},
+		{
+			MethodName: "RequeueItems",
+			Handler:    _QueueService_RequeueItems_Handler,
+		},
+		{
+			MethodName: "MoveItem",
+			Handler:    _QueueService_MoveItem_Handler,
+		},
+		{
+			MethodName: "MoveToFront",
+			Handler:    _QueueService_MoveToFront_Handler,
+		},
+		{
+			MethodName: "MoveToBack",
+			Handler:    _QueueService_MoveToBack_Handler,
+		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _QueueService_UpdateItem_Handler,
+		},
+		{
+			MethodName: "SetQueueRetryPolicy",
+			Handler:    _QueueService_SetQueueRetryPolicy_Handler,
+		},
+		{
+			MethodName: "GetQueueConfig",
+			Handler:    _QueueService_GetQueueConfig_Handler,
+		},
+		{
+			MethodName: "UpdateQueueConfig",
+			Handler:    _QueueService_UpdateQueueConfig_Handler,
+		},
+		{
+			MethodName: "RegisterWorker",
+			Handler:    _QueueService_RegisterWorker_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _QueueService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "ListWorkers",
+			Handler:    _QueueService_ListWorkers_Handler,
+		},
+		{
+			MethodName: "CreateKey",
+			Handler:    _QueueService_CreateKey_Handler,
+		},
+		{
+			MethodName: "RevokeKey",
+			Handler:    _QueueService_RevokeKey_Handler,
+		},
+		{
+			MethodName: "RotateKey",
+			Handler:    _QueueService_RotateKey_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _QueueService_ListKeys_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _QueueService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _QueueService_ListCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _QueueService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _QueueService_DeleteCategory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueue",
+			Handler:       _QueueService_WatchQueue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _QueueService_Backup_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "queue-service.proto",
 }
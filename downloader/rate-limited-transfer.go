@@ -6,6 +6,7 @@ import (
 	"golang.org/x/time/rate"
 	"io"
 	"sync"
+	"time"
 )
 
 const (
@@ -64,9 +65,11 @@ func (r *RateLimiter) Transfer(ctx context.Context, rd io.Reader, w io.Writer) e
 	buf := make([]byte, eventTransferSizeBytes)
 
 	for {
+		start := time.Now()
 		if err := r.limiter().WaitN(ctx, eventTransferSizeBytes); err != nil {
 			return err
 		}
+		rateLimitWait.Add(time.Since(start).Seconds())
 
 		n, rdErr := rd.Read(buf)
 		_, wErr := w.Write(buf[:n])
@@ -75,10 +78,36 @@ func (r *RateLimiter) Transfer(ctx context.Context, rd io.Reader, w io.Writer) e
 			if rdErr == io.EOF {
 				return nil
 			}
-			return fmt.Errorf("read error: %w", rdErr)
+			return ReadError{Err: rdErr}
 		}
 		if wErr != nil {
-			return fmt.Errorf("write error: %w", rdErr)
+			return WriteError{Err: wErr}
 		}
 	}
 }
+
+// ReadError is returned by Transfer when reading from the source fails.
+type ReadError struct {
+	Err error
+}
+
+func (e ReadError) Error() string {
+	return fmt.Sprintf("read error: %v", e.Err)
+}
+
+func (e ReadError) Unwrap() error {
+	return e.Err
+}
+
+// WriteError is returned by Transfer when writing to the destination fails.
+type WriteError struct {
+	Err error
+}
+
+func (e WriteError) Error() string {
+	return fmt.Sprintf("write error: %v", e.Err)
+}
+
+func (e WriteError) Unwrap() error {
+	return e.Err
+}
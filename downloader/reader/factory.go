@@ -3,6 +3,7 @@ package reader
 import (
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 )
 
@@ -10,6 +11,15 @@ type OpenReadCloser interface {
 	OpenReadCloser() (io.ReadCloser, int64, error)
 }
 
+// OpenFromReadCloser is implemented by sources which can be read from part way through, so that
+// an earlier partial download can be carried on.
+type OpenFromReadCloser interface {
+	// OpenReadCloserFrom opens the source at offset.  It returns the offset the reader actually
+	// starts at, which is zero if the source can't start part way through, and the total size of
+	// the source.
+	OpenReadCloserFrom(offset int64) (io.ReadCloser, int64, int64, error)
+}
+
 func BuildFromURL(uri string) (OpenReadCloser, error) {
 	colon := strings.Index(uri, ":")
 	if colon < 0 {
@@ -28,3 +38,13 @@ var factory = map[string]func(uri string) (OpenReadCloser, error){
 	"http":  ParseHTTPItemFromURL,
 	"https": ParseHTTPItemFromURL,
 }
+
+// Schemes returns the URL schemes which can be read from, in alphabetical order.
+func Schemes() []string {
+	out := make([]string, 0, len(factory))
+	for scheme := range factory {
+		out = append(out, scheme)
+	}
+	slices.Sort(out)
+	return out
+}
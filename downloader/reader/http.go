@@ -1,13 +1,18 @@
 package reader
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
+// maxRedirects is the number of redirects a request follows, as http.DefaultClient does.
+const maxRedirects = 10
+
 const (
 	HttpType          = "http"
 	DefaultUserAgent  = "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:99.0) Gecko/20100101 Firefox/99.0"
@@ -69,6 +74,9 @@ type HTTPSourceConfiguration struct {
 	Body      io.Reader
 	Auth      AuthType
 	UserAgent string
+	// CheckRedirect, if set, is called with the URL of each redirect before it is followed.  If
+	// it returns an error, the request fails with that error.
+	CheckRedirect func(u *url.URL) error
 }
 
 func (i *HTTPSourceConfiguration) Type() string {
@@ -98,29 +106,103 @@ func (i *HTTPSourceConfiguration) String() string {
 }
 
 func (i *HTTPSourceConfiguration) OpenReadCloser() (io.ReadCloser, int64, error) {
+	r, _, size, err := i.OpenReadCloserFrom(0)
+	return r, size, err
+}
+
+// OpenReadCloserFrom asks the server for the part of the resource from offset onwards.  Servers
+// which don't support ranges send the whole resource, in which case the returned offset is zero.
+// If the server sends a range starting elsewhere, the whole resource is requested instead.
+func (i *HTTPSourceConfiguration) OpenReadCloserFrom(offset int64) (io.ReadCloser, int64, int64, error) {
 	method := defaultValue(http.MethodGet, i.Method)
 	req, err := http.NewRequest(method, i.URL.String(), i.Body)
 	if err != nil {
-		return nil, 0, fmt.Errorf("http: error building request: %w", err)
+		return nil, 0, 0, fmt.Errorf("http: error building request: %w", err)
 	}
 	req.Header.Set("User-Agent", defaultValue(DefaultUserAgent, i.UserAgent))
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
 
 	if i.Auth != nil {
 		if err := i.Auth.ConfigureAuth(req); err != nil {
-			return nil, 0, fmt.Errorf("http: error configuring auth: %w", err)
+			return nil, 0, 0, fmt.Errorf("http: error configuring auth: %w", err)
 		}
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := i.client().Do(req)
 	if err != nil {
-		return nil, 0, fmt.Errorf("http: error making request: %w", err)
+		return nil, 0, 0, fmt.Errorf("http: error making request: %w", err)
 	}
-	if resp.StatusCode != http.StatusOK {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return resp.Body, 0, resp.ContentLength, nil
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		start, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil || start != offset {
+			// the server sent a different part of the resource from the one asked for, so
+			// download the whole of it instead
+			resp.Body.Close()
+			return i.OpenReadCloserFrom(0)
+		}
+		if size < 0 && resp.ContentLength >= 0 {
+			size = offset + resp.ContentLength
+		}
+		return resp.Body, offset, size, nil
+	default:
 		resp.Body.Close()
-		return nil, 0, fmt.Errorf("http: got a %v response from the server", resp.StatusCode)
+		return nil, 0, 0, StatusError{StatusCode: resp.StatusCode}
+	}
+}
+
+// parseContentRange returns the first byte and the total size given by a Content-Range header,
+// such as "bytes 100-999/1000".  The size is -1 if the server didn't give it.
+func parseContentRange(header string) (int64, int64, error) {
+	rng, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, 0, fmt.Errorf("http: unsupported content range %q", header)
+	}
+	span, total, ok := strings.Cut(rng, "/")
+	first, _, ok2 := strings.Cut(span, "-")
+	if !ok || !ok2 {
+		return 0, 0, fmt.Errorf("http: malformed content range %q", header)
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("http: malformed content range %q: %w", header, err)
+	}
+	if total == "*" {
+		return start, -1, nil
+	}
+	size, err := strconv.ParseInt(total, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("http: malformed content range %q: %w", header, err)
 	}
+	return start, size, nil
+}
+
+// client returns the client to make requests with, which checks redirects if asked to.
+func (i *HTTPSourceConfiguration) client() *http.Client {
+	if i.CheckRedirect == nil {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return errors.New("stopped after 10 redirects")
+			}
+			return i.CheckRedirect(req.URL)
+		},
+	}
+}
+
+// StatusError is returned when the server responds with a status other than 200 OK.
+type StatusError struct {
+	StatusCode int
+}
 
-	return resp.Body, resp.ContentLength, nil
+func (e StatusError) Error() string {
+	return fmt.Sprintf("http: got a %v response from the server", e.StatusCode)
 }
 
 func defaultValue[T comparable](def, val T) T {
@@ -0,0 +1,98 @@
+package reader
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testContent = "0123456789"
+
+var modTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestHTTPSourceConfiguration_OpenReadCloserFrom(t *testing.T) {
+	tests := []struct {
+		name      string
+		handler   http.HandlerFunc
+		wantStart int64
+		wantBody  string
+	}{
+		{
+			name: "ignores range",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, testContent)
+			},
+			wantStart: 0,
+			wantBody:  testContent,
+		},
+		{
+			name: "partial content",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.ServeContent(w, r, "", modTime, strings.NewReader(testContent))
+			},
+			wantStart: 4,
+			wantBody:  testContent[4:],
+		},
+		{
+			name: "mismatched range",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Range") == "" {
+					io.WriteString(w, testContent)
+					return
+				}
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes 2-9/%d", len(testContent)))
+				w.WriteHeader(http.StatusPartialContent)
+				io.WriteString(w, testContent[2:])
+			},
+			wantStart: 0,
+			wantBody:  testContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.handler)
+			defer srv.Close()
+			src, err := ParseHTTPItemFromURL(srv.URL)
+			if err != nil {
+				t.Fatalf("unable to parse url: %v", err)
+			}
+			r, start, size, err := src.(OpenFromReadCloser).OpenReadCloserFrom(4)
+			if err != nil {
+				t.Fatalf("unable to open source: %v", err)
+			}
+			defer r.Close()
+			body, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unable to read source: %v", err)
+			}
+			if start != tt.wantStart || size != int64(len(testContent)) || string(body) != tt.wantBody {
+				t.Errorf("expected %q from %d of %d, got %q from %d of %d", tt.wantBody, tt.wantStart, len(testContent), body, start, size)
+			}
+		})
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		header    string
+		wantStart int64
+		wantSize  int64
+		wantErr   bool
+	}{
+		{"bytes 100-999/1000", 100, 1000, false},
+		{"bytes 100-999/*", 100, -1, false},
+		{"bytes */1000", 0, 0, true},
+		{"items 1-2/3", 0, 0, true},
+		{"", 0, 0, true},
+	}
+	for _, tt := range tests {
+		start, size, err := parseContentRange(tt.header)
+		if (err != nil) != tt.wantErr || start != tt.wantStart || size != tt.wantSize {
+			t.Errorf("%q: expected %d, %d, error %v, got %d, %d, %v", tt.header, tt.wantStart, tt.wantSize, tt.wantErr, start, size, err)
+		}
+	}
+}
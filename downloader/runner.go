@@ -2,12 +2,17 @@ package downloader
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/harryrose/godm/downloader/queue"
 	"github.com/harryrose/godm/downloader/reader"
 	"github.com/harryrose/godm/downloader/writer"
 	"github.com/harryrose/godm/log"
 	"github.com/harryrose/godm/log/keys"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"io"
 	"sync/atomic"
 	"time"
@@ -17,38 +22,68 @@ const (
 	updatePeriod = 3 * time.Second
 )
 
-func Run(ctx context.Context, client queue.QueueServiceClient, pollPeriod time.Duration, queueName string, rateLimitBytesPerSecond int) {
+// errLeaseLost is returned when another downloader has claimed the item being downloaded, so
+// the download was abandoned.
+var errLeaseLost = errors.New("the claim on the item was superseded")
+
+func Run(ctx context.Context, client queue.QueueServiceClient, worker *Worker, pollPeriod time.Duration, queueName string, rateLimitBytesPerSecond int) {
 	ticker := time.Tick(pollPeriod)
 	for range ticker {
 		log.Infow("polling for next item", "queue", queueName)
-		claimed, err := client.ClaimNextItem(ctx, &queue.ClaimNextItemInput{
+		pollCtx, pollSpan := tracer.Start(ctx, "poll", trace.WithAttributes(attribute.String("godm.queue", queueName)))
+		claimed, err := client.ClaimNextItem(pollCtx, &queue.ClaimNextItemInput{
 			Queue: &queue.Identifier{
 				Id: queueName,
 			},
+			WorkerId: worker.ID(),
 		})
 		if err != nil {
 			log.Warnw("failed to claim next item", "queue", queueName, keys.Error, err)
+			polls.WithLabelValues(pollError).Inc()
+			endSpan(pollSpan, err)
 			continue
 		}
 		if claimed.Id == nil {
 			log.Infow("no items to claim", "queue", queueName)
+			polls.WithLabelValues(pollEmpty).Inc()
+			pollSpan.End()
 			continue
 		}
+		polls.WithLabelValues(pollClaimed).Inc()
 
 		src := claimed.Item.Source.Url
 		dst := claimed.Item.Destination.Url
 		id := claimed.Id.Id
+		lease := claimed.LeaseToken
+		rateLimit := rateLimitBytesPerSecond
+		if claimed.RateLimitBytesPerSecond > 0 {
+			// the category's or queue's rate limit takes precedence over our own
+			rateLimit = int(claimed.RateLimitBytesPerSecond)
+		}
 
-		bytesWritten, totalSizeBytes, err := handleItem(ctx, client, id, src, dst, rateLimitBytesPerSecond)
-		if err != nil {
+		worker.SetCurrentItem(id)
+		start := time.Now()
+		itemCtx, span := startItemSpan(pollCtx, claimed)
+		bytesWritten, totalSizeBytes, err := handleItem(itemCtx, client, id, lease, src, dst, rateLimit, int64(claimed.ResumeFromBytes), claimed.Category)
+		worker.SetCurrentItem("")
+		throughput.Set(0)
+		if errors.Is(err, errLeaseLost) {
+			// the item belongs to another downloader now, so its state is theirs to report
+			log.Warnw("download abandoned", "item_id", id, keys.Error, err)
+			itemDuration.WithLabelValues(resultAbandoned).Observe(time.Since(start).Seconds())
+		} else if err != nil {
 			log.Warnw("download failed", keys.Error, err)
-			_, err := client.SetItemState(ctx, &queue.SetItemStateInput{
-				Item: &queue.Identifier{Id: id},
+			itemDuration.WithLabelValues(resultFailed).Observe(time.Since(start).Seconds())
+			failures.WithLabelValues(failureLabel(failureClass(err))).Inc()
+			_, err := client.SetItemState(itemCtx, &queue.SetItemStateInput{
+				Item:       &queue.Identifier{Id: id},
+				LeaseToken: lease,
 				State: &queue.ItemState{
 					State:           queue.ItemState_ITEM_STATE_FAILED,
 					TotalSizeBytes:  uint64(totalSizeBytes),
 					DownloadedBytes: uint64(bytesWritten),
 					Message:         err.Error(),
+					FailureClass:    failureClass(err),
 				},
 			})
 			if err != nil {
@@ -56,8 +91,10 @@ func Run(ctx context.Context, client queue.QueueServiceClient, pollPeriod time.D
 			}
 		} else {
 			log.Infow("download complete", "item_id", id, "bytes_written", bytesWritten, "total_bytes", totalSizeBytes)
-			_, err := client.SetItemState(ctx, &queue.SetItemStateInput{
-				Item: &queue.Identifier{Id: id},
+			itemDuration.WithLabelValues(resultComplete).Observe(time.Since(start).Seconds())
+			_, err := client.SetItemState(itemCtx, &queue.SetItemStateInput{
+				Item:       &queue.Identifier{Id: id},
+				LeaseToken: lease,
 				State: &queue.ItemState{
 					State:           queue.ItemState_ITEM_STATE_COMPLETE,
 					TotalSizeBytes:  uint64(totalSizeBytes),
@@ -68,50 +105,85 @@ func Run(ctx context.Context, client queue.QueueServiceClient, pollPeriod time.D
 				log.Warnw("error setting item state to complete", keys.Error, err)
 			}
 		}
+		endSpan(span, err)
+		pollSpan.End()
 	}
 }
 
-func handleItem(ctx context.Context, client queue.QueueServiceClient, id, src, dst string, rateLimitBytesPerSecond int) (int64, int64, error) {
+func handleItem(ctx context.Context, client queue.QueueServiceClient, id string, lease uint64, src, dst string, rateLimitBytesPerSecond int, resumeFrom int64, category *queue.CategoryConfig) (int64, int64, error) {
+	if err := checkAllowedHost(category, src); err != nil {
+		return 0, 0, err
+	}
+	dst = categoryDestination(category, src, dst, time.Now())
 	log.Infow("starting download of item", "item_id", id, "src", src, "dst", dst, "rate_limit_bytes_per_second", rateLimitBytesPerSecond)
 
 	rdr, err := reader.BuildFromURL(src)
 	if err != nil {
-		return 0, 0, fmt.Errorf("error constructing downloader for url %v: %w", src, err)
+		return 0, 0, fail(queue.FailureClass_FAILURE_CLASS_INVALID, fmt.Errorf("error constructing downloader for url %v: %w", src, err))
 	}
+	restrictRedirects(category, rdr)
 	wrt, err := writer.BuildFromURL(dst)
 	if err != nil {
-		return 0, 0, fmt.Errorf("error constructing writer for url %v: %w", dst, err)
+		return 0, 0, fail(queue.FailureClass_FAILURE_CLASS_INVALID, fmt.Errorf("error constructing writer for url %v: %w", dst, err))
 	}
-	r, totalSizeBytes, err := rdr.OpenReadCloser()
+	_, span := tracer.Start(ctx, "openSource", trace.WithAttributes(attribute.String("godm.source", src)))
+	var r io.ReadCloser
+	var start, totalSizeBytes int64
+	if offset := resumeOffset(rdr, wrt, resumeFrom); offset > 0 {
+		r, start, totalSizeBytes, err = rdr.(reader.OpenFromReadCloser).OpenReadCloserFrom(offset)
+	} else {
+		r, totalSizeBytes, err = rdr.OpenReadCloser()
+	}
+	span.SetAttributes(attribute.Int64("godm.resumed_from", start))
+	endSpan(span, err)
 	if err != nil {
+		var status reader.StatusError
+		if !errors.As(err, &status) && !errors.As(err, &failure{}) {
+			// the server couldn't be reached, or didn't respond
+			err = fail(queue.FailureClass_FAILURE_CLASS_NETWORK, err)
+		}
 		return 0, totalSizeBytes, fmt.Errorf("error opening %v: %w", src, err)
 	}
 	defer r.Close()
 
-	w, err := wrt.OpenWriteCloser()
+	_, span = tracer.Start(ctx, "openDestination", trace.WithAttributes(attribute.String("godm.destination", dst)))
+	var w io.WriteCloser
+	if start > 0 {
+		log.Infow("resuming download of item", "item_id", id, "offset", start)
+		w, err = wrt.(writer.OpenAtWriterCloser).OpenWriteCloserAt(start)
+	} else {
+		w, err = wrt.OpenWriteCloser()
+	}
+	endSpan(span, err)
 	if err != nil {
-		return 0, totalSizeBytes, fmt.Errorf("error opening %v: %w", dst, err)
+		return 0, totalSizeBytes, fail(queue.FailureClass_FAILURE_CLASS_LOCAL, fmt.Errorf("error opening %v: %w", dst, err))
 	}
 	defer w.Close()
 
 	transferer := NewRateLimiter(rateLimitBytesPerSecond)
 
 	cw := &AsyncByteCountingWriter{W: w}
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	// the resumed part of the item counts towards its progress
+	cw.bytesWritten.Store(start)
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
 
 	go func() {
 		tick := time.Tick(updatePeriod)
+		lastBytes, lastTime := start, time.Now()
 		for {
 			select {
 			case <-ctx.Done():
 				return
 
-			case <-tick:
+			case now := <-tick:
 				bytesWritten := cw.BytesWritten()
+				throughput.Set(float64(bytesWritten-lastBytes) / now.Sub(lastTime).Seconds())
+				lastBytes, lastTime = bytesWritten, now
 				log.Infow("downloading item", "item_id", id, "bytes_written", bytesWritten, "total_bytes", totalSizeBytes)
 				_, err := client.SetItemState(ctx, &queue.SetItemStateInput{
-					Item: &queue.Identifier{Id: id},
+					Item:       &queue.Identifier{Id: id},
+					LeaseToken: lease,
 					State: &queue.ItemState{
 						State:           queue.ItemState_ITEM_STATE_DOWNLOADING,
 						TotalSizeBytes:  uint64(totalSizeBytes),
@@ -119,6 +191,11 @@ func handleItem(ctx context.Context, client queue.QueueServiceClient, id, src, d
 						Message:         "",
 					},
 				})
+				if status.Code(err) == codes.FailedPrecondition {
+					// stop writing to the destination before the new claimant does
+					cancel(errLeaseLost)
+					return
+				}
 				if err != nil {
 					log.Warnw("error updating state for item", "item_id", id, keys.Error, err)
 				}
@@ -126,13 +203,40 @@ func handleItem(ctx context.Context, client queue.QueueServiceClient, id, src, d
 		}
 	}()
 
-	if err := transferer.Transfer(ctx, r, cw); err != nil {
+	_, span = tracer.Start(ctx, "transfer", trace.WithAttributes(attribute.Int64("godm.total_size_bytes", totalSizeBytes)))
+	err = transferer.Transfer(ctx, r, cw)
+	span.SetAttributes(attribute.Int64("godm.bytes_written", cw.BytesWritten()))
+	endSpan(span, err)
+	if err != nil {
+		if cause := context.Cause(ctx); errors.Is(cause, errLeaseLost) {
+			return cw.BytesWritten(), totalSizeBytes, cause
+		}
 		return cw.BytesWritten(), totalSizeBytes, fmt.Errorf("transfer error: %w", err)
 	}
 
 	return cw.BytesWritten(), totalSizeBytes, nil
 }
 
+// resumeOffset returns where a download which an earlier attempt got resumeFrom bytes into can
+// carry on from, or zero if it has to start again.  Both the source and the destination must
+// support resuming, and the destination must still hold what was downloaded.
+func resumeOffset(rdr reader.OpenReadCloser, wrt writer.OpenWriterCloser, resumeFrom int64) int64 {
+	if resumeFrom <= 0 {
+		return 0
+	}
+	if _, ok := rdr.(reader.OpenFromReadCloser); !ok {
+		return 0
+	}
+	dest, ok := wrt.(writer.OpenAtWriterCloser)
+	if !ok {
+		return 0
+	}
+	if size, err := dest.Size(); err != nil || size < resumeFrom {
+		return 0
+	}
+	return resumeFrom
+}
+
 type AsyncByteCountingWriter struct {
 	W            io.Writer
 	bytesWritten atomic.Int64
@@ -141,6 +245,7 @@ type AsyncByteCountingWriter struct {
 func (w *AsyncByteCountingWriter) Write(bs []byte) (int, error) {
 	n, err := w.W.Write(bs)
 	w.bytesWritten.Add(int64(n))
+	bytesTransferred.Add(float64(n))
 	return n, err
 }
 
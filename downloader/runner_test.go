@@ -0,0 +1,48 @@
+package downloader
+
+import (
+	"github.com/harryrose/godm/downloader/reader"
+	"github.com/harryrose/godm/downloader/writer"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// wholeSource is a source which can't be read from part way through.
+type wholeSource struct{}
+
+func (wholeSource) OpenReadCloser() (io.ReadCloser, int64, error) {
+	return nil, 0, io.EOF
+}
+
+func TestResumeOffset(t *testing.T) {
+	dir := t.TempDir()
+	partial := &writer.FileSourceConfiguration{Path: filepath.Join(dir, "partial")}
+	if err := os.WriteFile(partial.Path, make([]byte, 100), 0o644); err != nil {
+		t.Fatalf("unable to write partial download: %v", err)
+	}
+	missing := &writer.FileSourceConfiguration{Path: filepath.Join(dir, "missing")}
+	src := &reader.HTTPSourceConfiguration{URL: &url.URL{Scheme: "http", Host: "example.com"}}
+
+	tests := []struct {
+		name       string
+		rdr        reader.OpenReadCloser
+		wrt        writer.OpenWriterCloser
+		resumeFrom int64
+		want       int64
+	}{
+		{"nothing to resume", src, partial, 0, 0},
+		{"resumable", src, partial, 80, 80},
+		{"all of the destination", src, partial, 100, 100},
+		{"destination too short", src, partial, 120, 0},
+		{"destination missing", src, missing, 80, 0},
+		{"source can't resume", wholeSource{}, partial, 80, 0},
+	}
+	for _, tt := range tests {
+		if got := resumeOffset(tt.rdr, tt.wrt, tt.resumeFrom); got != tt.want {
+			t.Errorf("%v: expected to resume from %d, got %d", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package downloader
+
+import (
+	"context"
+	"github.com/harryrose/godm/downloader/queue"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/harryrose/godm/downloader")
+
+// startItemSpan starts the span of a claimed item's download, as part of the poll which claimed
+// it.  The span is linked to the trace of the call which enqueued the item, if it was traced.
+func startItemSpan(ctx context.Context, claimed *queue.ClaimNextItemResult) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.String("godm.item_id", claimed.Id.Id),
+			attribute.String("godm.source", claimed.Item.Source.Url),
+			attribute.String("godm.destination", claimed.Item.Destination.Url),
+		),
+	}
+	enqueued := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{"traceparent": claimed.TraceParent})
+	if sc := trace.SpanContextFromContext(enqueued); sc.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sc}))
+	}
+	return tracer.Start(ctx, "handleItem", opts...)
+}
+
+// endSpan ends the span, recording err on it if it isn't nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,99 @@
+package downloader
+
+import (
+	"context"
+	"github.com/harryrose/godm/downloader/queue"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/keys"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// defaultHeartbeatInterval is used until the queue service says otherwise.
+const defaultHeartbeatInterval = 10 * time.Second
+
+// Worker keeps the downloader registered with the queue service, so that the service knows
+// which downloaders exist and what they are working on.
+type Worker struct {
+	Client queue.QueueServiceClient
+	// Info describes the downloader to the queue service.
+	Info *queue.RegisterWorkerInput
+
+	mux         sync.Mutex
+	id          string
+	currentItem string
+}
+
+// ID returns the id assigned to the worker by the queue service, or an empty string if it isn't
+// registered.
+func (w *Worker) ID() string {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	return w.id
+}
+
+// SetCurrentItem records the item being downloaded, which is reported with the next heartbeat.
+func (w *Worker) SetCurrentItem(id string) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.currentItem = id
+}
+
+// Start registers the worker and sends heartbeats in the background until the context is
+// cancelled.  If the worker can't register, or its registration expires, it registers again at
+// the next heartbeat.  Downloads carry on regardless.
+func (w *Worker) Start(ctx context.Context) {
+	interval := w.register(ctx)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+			if w.ID() == "" || !w.heartbeat(ctx) {
+				interval = w.register(ctx)
+			}
+		}
+	}()
+}
+
+// register registers the worker, and returns the interval at which to send heartbeats.
+func (w *Worker) register(ctx context.Context) time.Duration {
+	res, err := w.Client.RegisterWorker(ctx, w.Info)
+	if err != nil {
+		log.Warnw("error registering worker", "name", w.Info.Name, keys.Error, err)
+		return defaultHeartbeatInterval
+	}
+	log.Infow("registered worker", "worker_id", res.WorkerId)
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.id = res.WorkerId
+	if interval := res.HeartbeatInterval.AsDuration(); interval > 0 {
+		return interval
+	}
+	return defaultHeartbeatInterval
+}
+
+// heartbeat sends a heartbeat, returning false if the worker needs to register again.
+func (w *Worker) heartbeat(ctx context.Context) bool {
+	w.mux.Lock()
+	in := &queue.HeartbeatInput{WorkerId: w.id}
+	if w.currentItem != "" {
+		in.CurrentItem = &queue.Identifier{Id: w.currentItem}
+	}
+	w.mux.Unlock()
+
+	_, err := w.Client.Heartbeat(ctx, in)
+	if status.Code(err) == codes.NotFound {
+		log.Warnw("worker registration expired", "worker_id", in.WorkerId)
+		return false
+	}
+	if err != nil {
+		log.Warnw("error sending heartbeat", "worker_id", in.WorkerId, keys.Error, err)
+	}
+	return true
+}
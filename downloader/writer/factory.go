@@ -10,6 +10,16 @@ type OpenWriterCloser interface {
 	OpenWriteCloser() (io.WriteCloser, error)
 }
 
+// OpenAtWriterCloser is implemented by destinations which can keep the start of an earlier
+// partial download, so that it can be carried on.
+type OpenAtWriterCloser interface {
+	// Size returns how much the destination already holds.
+	Size() (int64, error)
+	// OpenWriteCloserAt opens the destination to be written from offset, keeping what comes
+	// before it and discarding anything after it.
+	OpenWriteCloserAt(offset int64) (io.WriteCloser, error)
+}
+
 func BuildFromURL(uri string) (OpenWriterCloser, error) {
 	colon := strings.Index(uri, ":")
 	if colon < 0 {
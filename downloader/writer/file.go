@@ -63,5 +63,33 @@ func (f *FileSourceConfiguration) String() string {
 }
 
 func (f *FileSourceConfiguration) OpenWriteCloser() (io.WriteCloser, error) {
+	// destinations may be placed in subdirectories, e.g. by a queue's destination prefix
+	if err := os.MkdirAll(path.Dir(f.Path), 0o755); err != nil {
+		return nil, fmt.Errorf("error creating directory for %v: %w", f.Path, err)
+	}
 	return os.Create(f.Path)
 }
+
+func (f *FileSourceConfiguration) Size() (int64, error) {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+func (f *FileSourceConfiguration) OpenWriteCloserAt(offset int64) (io.WriteCloser, error) {
+	file, err := os.OpenFile(f.Path, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error truncating %v: %w", f.Path, err)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error seeking in %v: %w", f.Path, err)
+	}
+	return file, nil
+}
@@ -0,0 +1,35 @@
+package writer
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSourceConfiguration_OpenWriteCloserAt(t *testing.T) {
+	f := &FileSourceConfiguration{Path: filepath.Join(t.TempDir(), "partial")}
+	if _, err := f.Size(); err == nil {
+		t.Errorf("expected a missing file to have no size")
+	}
+	w, err := f.OpenWriteCloser()
+	if err != nil {
+		t.Fatalf("unable to open file: %v", err)
+	}
+	io.WriteString(w, "0123456789")
+	w.Close()
+	if size, err := f.Size(); err != nil || size != 10 {
+		t.Errorf("expected a size of 10, got %v, %v", size, err)
+	}
+
+	// what comes after the offset is discarded, and the rest is appended to
+	w, err = f.OpenWriteCloserAt(4)
+	if err != nil {
+		t.Fatalf("unable to open file at offset: %v", err)
+	}
+	io.WriteString(w, "ab")
+	w.Close()
+	if got, err := os.ReadFile(f.Path); err != nil || string(got) != "0123ab" {
+		t.Errorf("expected 0123ab, got %q, %v", got, err)
+	}
+}
@@ -0,0 +1,42 @@
+// Package clienttls builds the transport credentials which GoDM's binaries connect to the queue
+// service with, so that they all treat their TLS flags in the same way.
+package clienttls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"os"
+)
+
+// Credentials returns the credentials to connect to the queue service with.  The connection is
+// encrypted if enabled is set, or if any of the files are given, which implies it.  The service's
+// certificate is verified against the PEM CA certificates in caFile if it is given, or the
+// system's CAs if not.  The PEM client certificate in certFile, with the private key in keyFile,
+// is presented if they are given, which the service may accept in place of a key.
+func Credentials(enabled bool, caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	if !enabled && caFile == "" && certFile == "" && keyFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		bs, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificates: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no certificates found in %v", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,43 @@
+package clienttls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCredentials(t *testing.T) {
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		enabled                   bool
+		caFile, certFile, keyFile string
+		protocol                  string
+		invalid                   bool
+	}{
+		"insecure":                  {protocol: "insecure"},
+		"enabled":                   {enabled: true, protocol: "tls"},
+		"CA without certificates":   {caFile: empty, invalid: true},
+		"missing CA":                {caFile: empty + ".missing", invalid: true},
+		"certificate without a key": {certFile: empty, invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			creds, err := Credentials(test.enabled, test.caFile, test.certFile, test.keyFile)
+			if test.invalid {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := creds.Info().SecurityProtocol; got != test.protocol {
+				t.Errorf("expected %v credentials, got %v", test.protocol, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Package tracing configures the export of OpenTelemetry traces, in the same way for each of
+// GoDM's binaries.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"io"
+	"net/url"
+)
+
+// Exporters which spans can be sent to.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+var Exporters = []string{ExporterNone, ExporterStdout, ExporterOTLP}
+
+// ErrInvalidConfig is wrapped by the errors Init returns when the exporter or endpoint it is given
+// isn't recognised, as opposed to when the exporter can't be created.
+var ErrInvalidConfig = errors.New("invalid trace configuration")
+
+// Config describes where spans are sent, and the service they are from.
+type Config struct {
+	// Exporter is one of Exporters.  If empty, spans aren't sent.
+	Exporter string
+	// Endpoint is the address of the OTLP collector, such as localhost:4317, or a URL; an address
+	// or http:// URL is connected to without TLS.  If empty, the collector given by the
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable, or a local one, is used.
+	Endpoint string
+	Service  string
+	// Version is the version of the service, which is left out of its spans if empty.
+	Version string
+	// Stdout is where the stdout exporter writes spans, instead of standard output if it isn't
+	// nil.
+	Stdout io.Writer
+}
+
+// Init installs a global tracer provider which sends spans as configured, and propagates trace
+// context in the W3C format.  The returned function flushes any spans which haven't been sent.
+func Init(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exp sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		var opts []stdouttrace.Option
+		if config.Stdout != nil {
+			opts = append(opts, stdouttrace.WithWriter(config.Stdout))
+		}
+		exp, err = stdouttrace.New(opts...)
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if config.Endpoint != "" {
+			opts, err = endpointOptions(config.Endpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+		exp, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("%w: unrecognised trace exporter %q", ErrInvalidConfig, config.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trace exporter: %w", err)
+	}
+
+	attributes := []attribute.KeyValue{attribute.String("service.name", config.Service)}
+	if config.Version != "" {
+		attributes = append(attributes, attribute.String("service.version", config.Version))
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewSchemaless(attributes...)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func endpointOptions(endpoint string) ([]otlptracegrpc.Option, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		// not a URL, so an address
+		return []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint), otlptracegrpc.WithInsecure()}, nil
+	}
+	switch u.Scheme {
+	case "http":
+		return []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(u.Host), otlptracegrpc.WithInsecure()}, nil
+	case "https":
+		return []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(u.Host)}, nil
+	default:
+		return nil, fmt.Errorf("%w: unrecognised scheme in trace endpoint %q", ErrInvalidConfig, endpoint)
+	}
+}
@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestInit(t *testing.T) {
+	tests := map[string]struct {
+		config  Config
+		invalid bool
+	}{
+		"none":                {config: Config{Exporter: ExporterNone}},
+		"unset":               {config: Config{}},
+		"unrecognised":        {config: Config{Exporter: "jaeger"}, invalid: true},
+		"address endpoint":    {config: Config{Exporter: ExporterOTLP, Endpoint: "localhost:4317"}},
+		"http endpoint":       {config: Config{Exporter: ExporterOTLP, Endpoint: "http://localhost:4317"}},
+		"https endpoint":      {config: Config{Exporter: ExporterOTLP, Endpoint: "https://collector.example.com"}},
+		"unrecognised scheme": {config: Config{Exporter: ExporterOTLP, Endpoint: "ftp://collector.example.com"}, invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			shutdown, err := Init(context.Background(), test.config)
+			if test.invalid {
+				if !errors.Is(err, ErrInvalidConfig) {
+					t.Fatalf("expected an invalid configuration error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := shutdown(context.Background()); err != nil {
+				t.Errorf("unable to shut down: %v", err)
+			}
+		})
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"github.com/harryrose/godm/log"
 	"github.com/harryrose/godm/log/keys"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -14,29 +19,94 @@ const (
 	AuthorizationKey = "authorization"
 )
 
-func AuthorizationInterceptor(key string) grpc.UnaryServerInterceptor {
-	authenticate := func(ctx context.Context) error {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			log.Warnw("authorization fail", keys.Error, "no metadata in context")
-			return status.Error(codes.Unauthenticated, "Unauthenticated")
+// Reasons for failures, as reported by failures.
+const (
+	failureUnauthenticated  = "unauthenticated"
+	failurePermissionDenied = "permission_denied"
+)
+
+var failures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "godm",
+	Subsystem: "auth",
+	Name:      "failures_total",
+	Help:      "The number of calls refused because the caller's key wasn't accepted, or its role doesn't allow the method.",
+}, []string{"reason"})
+
+func AuthorizationInterceptor(apiKeys *Keys) grpc.UnaryServerInterceptor {
+	authorize := authorizer(apiKeys)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func AuthorizationStreamInterceptor(apiKeys *Keys) grpc.StreamServerInterceptor {
+	authorize := authorizer(apiKeys)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorizer returns a function which checks that a call to the given method carries a key, or
+// a verified client certificate, whose role allows it, unless the method is public.
+func authorizer(apiKeys *Keys) func(ctx context.Context, method string) error {
+	return func(ctx context.Context, method string) error {
+		if Public(method) {
+			return nil
 		}
+		md, _ := metadata.FromIncomingContext(ctx)
 		values := md.Get(AuthorizationKey)
+		if len(values) == 0 {
+			if name, ok := certificateIdentity(ctx); ok {
+				key, ok := apiKeys.Identify(name)
+				if !ok {
+					log.Warnw("authorization fail", keys.Error, "no key for certificate", "identity", name)
+					failures.WithLabelValues(failureUnauthenticated).Inc()
+					return status.Error(codes.Unauthenticated, "Unauthenticated")
+				}
+				return authorize(key, method)
+			}
+		}
 		if l := len(values); l != 1 {
 			log.Warnw("authorization fail", keys.Error, "unexpected number of values", keys.Expected, 1, keys.Got, l)
+			failures.WithLabelValues(failureUnauthenticated).Inc()
 			return status.Error(codes.Unauthenticated, "Unauthenticated")
 		}
-		if values[0] != key {
+		key, ok := apiKeys.Authenticate(values[0])
+		if !ok {
 			log.Warnw("authorization fail", keys.Error, "incorrect key")
+			failures.WithLabelValues(failureUnauthenticated).Inc()
 			return status.Error(codes.Unauthenticated, "Unauthenticated")
 		}
-		return nil
+		return authorize(key, method)
 	}
+}
 
-	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		if err := authenticate(ctx); err != nil {
-			return nil, err
-		}
-		return handler(ctx, req)
+func authorize(key *db.ApiKey, method string) error {
+	if !Allowed(key.Role, method) {
+		log.Warnw("authorization fail", keys.Error, "role not allowed", "key", key.Name, "role", key.Role.String(), "method", method)
+		failures.WithLabelValues(failurePermissionDenied).Inc()
+		return status.Error(codes.PermissionDenied, "PermissionDenied")
+	}
+	return nil
+}
+
+// certificateIdentity returns the common name of the caller's client certificate, if it presented
+// one which the server verified.  A certificate is given the role of the key with the same name.
+func certificateIdentity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
 	}
+	name := info.State.VerifiedChains[0][0].Subject.CommonName
+	return name, name != ""
 }
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+)
+
+// secretLengthBytes is the number of random bytes in a key's secret.
+const secretLengthBytes = 32
+
+// BootstrapKeyName is the name given to the bootstrap key.
+const BootstrapKeyName = "bootstrap"
+
+// Keys authenticates callers against the API keys held in the store.  Keys are cached, so
+// changes should be made through Keys, or followed by a call to Load.
+type Keys struct {
+	DB db.Store
+	// Bootstrap is a secret which is accepted with the admin role without being held in the
+	// store, so that the first keys can be created.  It is ignored if empty.
+	Bootstrap string
+
+	lock   sync.RWMutex
+	byHash map[string]*db.ApiKey
+	byName map[string]*db.ApiKey
+}
+
+// Load reads the keys from the store.
+func (k *Keys) Load() error {
+	keys, err := k.DB.ListKeys()
+	if err != nil {
+		return fmt.Errorf("unable to read keys: %w", err)
+	}
+	byHash := make(map[string]*db.ApiKey, len(keys))
+	byName := make(map[string]*db.ApiKey, len(keys))
+	for _, key := range keys {
+		byHash[string(key.Hash)] = key
+		byName[key.Name] = key
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.byHash = byHash
+	k.byName = byName
+	return nil
+}
+
+// Authenticate returns the key whose secret is given, if there is one.
+func (k *Keys) Authenticate(secret string) (*db.ApiKey, bool) {
+	if k.Bootstrap != "" && secret == k.Bootstrap {
+		return &db.ApiKey{Name: BootstrapKeyName, Role: db.Role_ROLE_ADMIN}, true
+	}
+	hash := hashSecret(secret)
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	key, ok := k.byHash[string(hash)]
+	return key, ok
+}
+
+// Identify returns the key with the given name, if there is one.  It is used for callers which
+// have proven their identity some other way, such as with a client certificate.
+func (k *Keys) Identify(name string) (*db.ApiKey, bool) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	key, ok := k.byName[name]
+	return key, ok
+}
+
+// List returns the stored keys, ordered by name.
+func (k *Keys) List() ([]*db.ApiKey, error) {
+	return k.DB.ListKeys()
+}
+
+// Create stores a new key with the given name and role, and returns it with its secret.  The
+// secret isn't kept, so can't be recovered later.
+func (k *Keys) Create(name string, role db.Role) (*db.ApiKey, string, error) {
+	if name == "" || name == BootstrapKeyName {
+		return nil, "", fmt.Errorf("key name %q is not allowed: %w", name, db.ErrInvalid{})
+	}
+	if _, ok := db.Role_name[int32(role)]; !ok || role == db.Role_ROLE_UNSPECIFIED {
+		return nil, "", fmt.Errorf("a role must be given: %w", db.ErrInvalid{})
+	}
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	key := &db.ApiKey{
+		Name:    name,
+		Role:    role,
+		Hash:    hashSecret(secret),
+		Created: timestamppb.Now(),
+	}
+	if err := k.DB.CreateKey(key); err != nil {
+		return nil, "", err
+	}
+	return key, secret, k.Load()
+}
+
+// Rotate replaces the secret of the named key, and returns the key with its new secret.  The old
+// secret stops working straight away.
+func (k *Keys) Rotate(name string) (*db.ApiKey, string, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := k.DB.UpdateKey(name, func(key *db.ApiKey) error {
+		key.Hash = hashSecret(secret)
+		key.Rotated = timestamppb.Now()
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return key, secret, k.Load()
+}
+
+// Revoke deletes the named key.
+func (k *Keys) Revoke(name string) error {
+	if err := k.DB.DeleteKey(name); err != nil {
+		return err
+	}
+	return k.Load()
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, secretLengthBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("unable to read random data: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) []byte {
+	hash := sha256.Sum256([]byte(secret))
+	return hash[:]
+}
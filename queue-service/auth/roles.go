@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"github.com/harryrose/godm/queue-service/db"
+	"slices"
+	"strings"
+)
+
+// servicePrefix begins the full names of the queue service's methods.
+const servicePrefix = "/queue_svc.QueueService/"
+
+// publicServices are the services whose methods may be called without a key, so that
+// supervisors and load balancers can check the service's health.
+var publicServices = []string{"/grpc.health.v1.Health/"}
+
+// readMethods are the methods which only read the service's state.
+var readMethods = []string{
+	"ListQueues",
+	"GetQueueItems",
+	"GetFinishedItems",
+	"WatchQueue",
+	"GetQueueConfig",
+	"ListWorkers",
+	"ListCategories",
+}
+
+// grants lists the methods each role may call.  Admin keys may call every method, and methods
+// which aren't listed are only available to them.
+var grants = map[db.Role][]string{
+	db.Role_ROLE_READ_ONLY: readMethods,
+	db.Role_ROLE_CLIENT: slices.Concat(readMethods, []string{
+		"EnqueueItem",
+		"CancelItem",
+		"MoveItem",
+		"MoveToFront",
+		"MoveToBack",
+		"UpdateItem",
+		"RequeueItems",
+	}),
+	db.Role_ROLE_WORKER: slices.Concat(readMethods, []string{
+		"ClaimNextItem",
+		"SetItemState",
+		"RegisterWorker",
+		"Heartbeat",
+	}),
+}
+
+// Allowed reports whether a key with the given role may call the method with the given full
+// name.
+func Allowed(role db.Role, fullMethod string) bool {
+	if role == db.Role_ROLE_ADMIN {
+		return true
+	}
+	method, ok := strings.CutPrefix(fullMethod, servicePrefix)
+	return ok && slices.Contains(grants[role], method)
+}
+
+// Public reports whether the method with the given full name may be called without a key.
+func Public(fullMethod string) bool {
+	return slices.ContainsFunc(publicServices, func(prefix string) bool {
+		return strings.HasPrefix(fullMethod, prefix)
+	})
+}
+
+// RoleName returns the name a role is given on the command line, which is its name in the proto
+// without the prefix, in lower case and hyphenated, such as read-only.
+func RoleName(role db.Role) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(role.String(), "ROLE_"), "_", "-"))
+}
+
+// ParseRole returns the role with the given name, as returned by RoleName.  The unspecified role
+// isn't accepted.
+func ParseRole(name string) (db.Role, bool) {
+	for _, value := range db.Role_value {
+		if role := db.Role(value); role != db.Role_ROLE_UNSPECIFIED && RoleName(role) == name {
+			return role, true
+		}
+	}
+	return db.Role_ROLE_UNSPECIFIED, false
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"github.com/harryrose/godm/queue-service/db"
+	"testing"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		role    db.Role
+		method  string
+		allowed bool
+	}{
+		{db.Role_ROLE_READ_ONLY, "ListQueues", true},
+		{db.Role_ROLE_READ_ONLY, "EnqueueItem", false},
+		{db.Role_ROLE_CLIENT, "EnqueueItem", true},
+		{db.Role_ROLE_CLIENT, "CancelItem", true},
+		{db.Role_ROLE_CLIENT, "ClaimNextItem", false},
+		{db.Role_ROLE_CLIENT, "ClearHistory", false},
+		{db.Role_ROLE_WORKER, "ClaimNextItem", true},
+		{db.Role_ROLE_WORKER, "SetItemState", true},
+		{db.Role_ROLE_WORKER, "EnqueueItem", false},
+		{db.Role_ROLE_ADMIN, "ClearHistory", true},
+		{db.Role_ROLE_ADMIN, "CreateKey", true},
+		{db.Role_ROLE_UNSPECIFIED, "ListQueues", false},
+	}
+	for _, test := range tests {
+		if got := Allowed(test.role, servicePrefix+test.method); got != test.allowed {
+			t.Errorf("%v calling %v: expected %v, got %v", test.role, test.method, test.allowed, got)
+		}
+	}
+	if Allowed(db.Role_ROLE_READ_ONLY, "/other.Service/ListQueues") {
+		t.Errorf("expected another service's method to be refused")
+	}
+}
+
+func TestPublic(t *testing.T) {
+	if !Public("/grpc.health.v1.Health/Check") {
+		t.Errorf("expected health checks to be public")
+	}
+	if Public(servicePrefix + "ListQueues") {
+		t.Errorf("expected the queue service's methods not to be public")
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys := &Keys{DB: db.NewMemory(), Bootstrap: "boot"}
+	if err := keys.Load(); err != nil {
+		t.Fatalf("unable to load keys: %v", err)
+	}
+	if key, ok := keys.Authenticate("boot"); !ok || key.Role != db.Role_ROLE_ADMIN {
+		t.Errorf("expected the bootstrap key to be an admin, got %v, %v", key, ok)
+	}
+
+	_, secret, err := keys.Create("ext", db.Role_ROLE_CLIENT)
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	if key, ok := keys.Authenticate(secret); !ok || key.Name != "ext" || key.Role != db.Role_ROLE_CLIENT {
+		t.Errorf("expected the client key, got %v, %v", key, ok)
+	}
+
+	_, rotated, err := keys.Rotate("ext")
+	if err != nil {
+		t.Fatalf("unable to rotate key: %v", err)
+	}
+	if _, ok := keys.Authenticate(secret); ok {
+		t.Errorf("expected the old secret to be refused")
+	}
+	if _, ok := keys.Authenticate(rotated); !ok {
+		t.Errorf("expected the new secret to be accepted")
+	}
+
+	if err := keys.Revoke("ext"); err != nil {
+		t.Fatalf("unable to revoke key: %v", err)
+	}
+	if _, ok := keys.Authenticate(rotated); ok {
+		t.Errorf("expected the revoked key to be refused")
+	}
+}
+
+func TestRoleNames(t *testing.T) {
+	tests := map[string]db.Role{
+		"read-only": db.Role_ROLE_READ_ONLY,
+		"client":    db.Role_ROLE_CLIENT,
+		"worker":    db.Role_ROLE_WORKER,
+		"admin":     db.Role_ROLE_ADMIN,
+	}
+	for name, role := range tests {
+		if got := RoleName(role); got != name {
+			t.Errorf("expected %v to be named %q, got %q", role, name, got)
+		}
+		if got, ok := ParseRole(name); !ok || got != role {
+			t.Errorf("expected %q to be %v, got %v, %v", name, role, got, ok)
+		}
+	}
+	for _, name := range []string{"", "unspecified", "read_only", "owner"} {
+		if role, ok := ParseRole(name); ok {
+			t.Errorf("expected %q not to be a role, got %v", name, role)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package queue_service
+
+import (
+	"fmt"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+)
+
+// backupChunkSize is the amount of the backup sent in each message.
+const backupChunkSize = 64 << 10
+
+// Backup writes the backup to a temporary file before streaming it, so that a slow client
+// doesn't hold the database's transaction open.
+func (s *Service) Backup(in *rpc.BackupInput, stream rpc.QueueService_BackupServer) error {
+	if in == nil {
+		return status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	f, err := os.CreateTemp("", "godm-backup-")
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to create temporary file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	switch in.Format {
+	case rpc.BackupInput_FORMAT_UNSPECIFIED, rpc.BackupInput_FORMAT_SNAPSHOT:
+		err = s.DB.Snapshot(f)
+	case rpc.BackupInput_FORMAT_JSONL:
+		err = s.DB.Export(f)
+	default:
+		return status.Errorf(codes.InvalidArgument, "unrecognised format: %v", in.Format)
+	}
+	if err != nil {
+		return coerceDBError(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "unable to read backup: %v", err)
+	}
+
+	buf := make([]byte, backupChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&rpc.BackupChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Sprintf("unable to read backup: %v", err))
+		}
+	}
+}
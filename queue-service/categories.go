@@ -0,0 +1,141 @@
+package queue_service
+
+import (
+	"context"
+	db2 "github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/functional"
+	"github.com/harryrose/godm/queue-service/queue"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path"
+	"slices"
+	"strings"
+)
+
+// categoryFields lists the paths of rpc.CategoryConfig which UpdateCategory accepts in its update
+// mask.
+var categoryFields = []string{
+	"destination_template",
+	"rate_limit_bytes_per_second",
+	"retry_policy",
+	"allowed_hosts",
+}
+
+func (s *Service) CreateCategory(ctx context.Context, in *rpc.CreateCategoryInput) (*rpc.CreateCategoryResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetCategory().GetId().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "category id must be provided and non-empty")
+	}
+	category, err := categoryFromRPC(in.Category)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DB.CreateCategory(category); err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.CreateCategoryResult{Category: categoryToRPC(category)}, nil
+}
+
+func (s *Service) ListCategories(ctx context.Context, in *rpc.ListCategoriesInput) (*rpc.ListCategoriesResult, error) {
+	categories, err := s.DB.ListCategories()
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.ListCategoriesResult{Categories: functional.Map(categories, categoryToRPC)}, nil
+}
+
+func (s *Service) UpdateCategory(ctx context.Context, in *rpc.UpdateCategoryInput) (*rpc.UpdateCategoryResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetCategory().GetId().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "category id must be provided and non-empty")
+	}
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = categoryFields
+	}
+	for _, p := range paths {
+		if !slices.Contains(categoryFields, p) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown category field %q", p)
+		}
+	}
+	settings, err := categoryFromRPC(in.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.DB.UpdateCategory(settings.Id, func(category *db2.CategoryConfig) error {
+		for _, p := range paths {
+			switch p {
+			case "destination_template":
+				category.DestinationTemplate = settings.DestinationTemplate
+			case "rate_limit_bytes_per_second":
+				category.RateLimit = settings.RateLimit
+			case "retry_policy":
+				category.RetryPolicy = settings.RetryPolicy
+			case "allowed_hosts":
+				category.AllowedHosts = settings.AllowedHosts
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.UpdateCategoryResult{Category: categoryToRPC(category)}, nil
+}
+
+func (s *Service) DeleteCategory(ctx context.Context, in *rpc.DeleteCategoryInput) (*rpc.DeleteCategoryResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetCategory().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "category id must be provided and non-empty")
+	}
+	if err := s.DB.DeleteCategory(in.Category.Id); err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.DeleteCategoryResult{}, nil
+}
+
+// categoryFromRPC validates a category's settings supplied by a client and converts them for
+// storage.
+func categoryFromRPC(category *rpc.CategoryConfig) (*db2.CategoryConfig, error) {
+	template := strings.TrimSpace(category.DestinationTemplate)
+	if path.IsAbs(template) {
+		return nil, status.Errorf(codes.InvalidArgument, "destination template must be a relative directory")
+	}
+	policy, err := retryPolicyFromRPC(category.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	var hosts []string
+	for _, host := range category.AllowedHosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" || host == "*." {
+			return nil, status.Errorf(codes.InvalidArgument, "allowed hosts must be non-empty")
+		}
+		hosts = append(hosts, host)
+	}
+	return &db2.CategoryConfig{
+		Id:                  category.Id.Id,
+		DestinationTemplate: template,
+		RateLimit:           category.RateLimitBytesPerSecond,
+		RetryPolicy:         policy,
+		AllowedHosts:        hosts,
+	}, nil
+}
+
+func categoryToRPC(category *db2.CategoryConfig) *rpc.CategoryConfig {
+	return &rpc.CategoryConfig{
+		Id:                      &queue.Identifier{Id: category.Id},
+		DestinationTemplate:     category.DestinationTemplate,
+		RateLimitBytesPerSecond: category.RateLimit,
+		RetryPolicy:             retryPolicyToRPC(category.RetryPolicy),
+		AllowedHosts:            category.AllowedHosts,
+	}
+}
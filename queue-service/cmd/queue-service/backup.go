@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	FlagAddress = "address"
+	FlagFormat  = "format"
+	ArgFile     = "file"
+
+	FormatSnapshot = "snapshot"
+	FormatJSONL    = "jsonl"
+)
+
+func backupCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "backup",
+		Usage:     "Fetch a backup from a running queue service",
+		ArgsUsage: "<file>",
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{
+				Name:        FlagAddress,
+				Aliases:     []string{"a"},
+				Usage:       "The address of the queue service",
+				DefaultText: "localhost on the service's port",
+			},
+			&cli.StringFlag{
+				Name:  FlagFormat,
+				Usage: "The format of the backup: " + FormatSnapshot + ", a copy of the database file, or " + FormatJSONL + ", an export which can be imported into any store",
+				Value: FormatSnapshot,
+			},
+		}, clientTLSFlags()...),
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:      ArgFile,
+				UsageText: "The file to write the backup to",
+			},
+		},
+		Action: backup,
+	}
+}
+
+func backup(ctx context.Context, cmd *cli.Command) error {
+	path := cmd.StringArg(ArgFile)
+	if path == "" {
+		return errors.New("the file to write the backup to is required")
+	}
+	format := rpc.BackupInput_FORMAT_SNAPSHOT
+	switch cmd.String(FlagFormat) {
+	case FormatSnapshot:
+	case FormatJSONL:
+		format = rpc.BackupInput_FORMAT_JSONL
+	default:
+		return fmt.Errorf("unrecognised format %q, expected %s or %s", cmd.String(FlagFormat), FormatSnapshot, FormatJSONL)
+	}
+	key := cmd.String(FlagKey)
+	if key == "" && cmd.String(FlagTLSCert) == "" {
+		return errors.New("the service's key, or a client certificate, is required")
+	}
+	address := cmd.String(FlagAddress)
+	if address == "" {
+		address = fmt.Sprintf("localhost:%d", cmd.Int(FlagPort))
+	}
+
+	creds, err := clientCredentials(cmd)
+	if err != nil {
+		return err
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("unable to connect to %v: %w", address, err)
+	}
+	defer conn.Close()
+	if key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", key)
+	}
+	stream, err := rpc.NewQueueServiceClient(conn).Backup(ctx, &rpc.BackupInput{Format: format})
+	if err != nil {
+		return fmt.Errorf("unable to start backup: %w", err)
+	}
+
+	// write to a temporary file alongside the backup, so that a failed backup doesn't leave a
+	// partial file in its place
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	size := 0
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving backup: %w", err)
+		}
+		n, err := f.Write(chunk.Data)
+		if err != nil {
+			return err
+		}
+		size += n
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d bytes to %s\n", size, path)
+	return nil
+}
+
+func restoreCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "restore",
+		Usage:     "Replace the database with a snapshot taken by backup. The service must not be running.",
+		ArgsUsage: "<file>",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:      ArgFile,
+				UsageText: "The snapshot to restore",
+			},
+		},
+		Action: restore,
+	}
+}
+
+// restore copies the snapshot alongside the database and checks that it opens before putting it
+// in place.  The database it replaces is kept, renamed.
+func restore(ctx context.Context, cmd *cli.Command) error {
+	path := cmd.StringArg(ArgFile)
+	if path == "" {
+		return errors.New("the snapshot to restore is required")
+	}
+	backend := cmd.String(FlagStore)
+	if backend == db.BackendMemory {
+		return errors.New("the memory store has no file to restore; use import instead")
+	}
+	dbPath := cmd.String(FlagDB)
+
+	staged := dbPath + ".restore"
+	if err := copyFile(path, staged); err != nil {
+		return fmt.Errorf("unable to copy snapshot: %w", err)
+	}
+	defer os.Remove(staged)
+	if err := checkOpens(backend, staged); err != nil {
+		return fmt.Errorf("snapshot can't be opened: %w", err)
+	}
+
+	if _, err := os.Stat(dbPath); err == nil {
+		// opening the database fails if the service holds it
+		if err := checkOpens(backend, dbPath); err != nil {
+			return fmt.Errorf("unable to open the current database, is the service running? %w", err)
+		}
+		replaced := fmt.Sprintf("%s.pre-restore-%s.bak", dbPath, time.Now().Format("20060102150405"))
+		if err := os.Rename(dbPath, replaced); err != nil {
+			return err
+		}
+		fmt.Printf("moved the current database to %s\n", replaced)
+	}
+	if err := os.Rename(staged, dbPath); err != nil {
+		return err
+	}
+	fmt.Printf("restored %s from %s\n", dbPath, path)
+	return nil
+}
+
+func checkOpens(backend string, path string) error {
+	database, err := db.Open(backend, path)
+	if err != nil {
+		return err
+	}
+	return database.Close()
+}
+
+func copyFile(from string, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func importCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "import",
+		Usage:     "Add the queues in a " + FormatJSONL + " backup to the database. The service must not be running.",
+		ArgsUsage: "<file>",
+		Arguments: []cli.Argument{
+			&cli.StringArg{
+				Name:      ArgFile,
+				UsageText: "The " + FormatJSONL + " backup to import",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			path := cmd.StringArg(ArgFile)
+			if path == "" {
+				return errors.New("the backup to import is required")
+			}
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			dbPath := cmd.String(FlagDB)
+			database, err := db.Open(cmd.String(FlagStore), dbPath)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+			if _, err := migrate(database, dbPath, false); err != nil {
+				return err
+			}
+			summary, err := database.Import(f)
+			if err != nil {
+				return fmt.Errorf("unable to import %v: %w", path, err)
+			}
+			fmt.Printf("imported %d queues, %d items, %d finished items, %d keys and %d categories\n", summary.Queues, summary.Items, summary.Finished, summary.Keys, summary.Categories)
+			return nil
+		},
+	}
+}
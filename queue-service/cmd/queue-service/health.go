@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"sync"
+	"time"
+)
+
+// healthCheckPeriod is how often the database is checked while the service is running.
+const healthCheckPeriod = 10 * time.Second
+
+// healthService is the standard gRPC health service, whose watches end when the service drains
+// so that they don't hold up its shutdown.
+type healthService struct {
+	*health.Server
+	draining chan struct{}
+}
+
+func newHealthService() *healthService {
+	return &healthService{Server: health.NewServer(), draining: make(chan struct{})}
+}
+
+// Drain reports every service as not serving, from now on, and ends any watches.
+func (h *healthService) Drain() {
+	h.Server.Shutdown()
+	close(h.draining)
+}
+
+// Watch is the standard watch, which ends once the watcher has been told that the service is no
+// longer serving after it starts to drain.
+func (h *healthService) Watch(in *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	watch := &healthWatchStream{Health_WatchServer: stream, ctx: ctx, cancel: cancel, draining: h.draining}
+	go func() {
+		select {
+		case <-h.draining:
+			watch.endIfDrained()
+		case <-ctx.Done():
+		}
+	}()
+	return h.Server.Watch(in, watch)
+}
+
+type healthWatchStream struct {
+	healthpb.Health_WatchServer
+	ctx      context.Context
+	cancel   context.CancelFunc
+	draining <-chan struct{}
+
+	lock sync.Mutex
+	// serving is whether the last status sent to the watcher was SERVING.
+	serving bool
+}
+
+func (s *healthWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *healthWatchStream) Send(resp *healthpb.HealthCheckResponse) error {
+	err := s.Health_WatchServer.Send(resp)
+	s.lock.Lock()
+	s.serving = resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+	s.lock.Unlock()
+	s.endIfDrained()
+	return err
+}
+
+// endIfDrained ends the watch if the service is draining and the watcher has already been sent a
+// status other than SERVING.  Otherwise the watch ends when that status is sent.
+func (s *healthWatchStream) endIfDrained() {
+	select {
+	case <-s.draining:
+	default:
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.serving {
+		s.cancel()
+	}
+}
+
+// CheckDatabase reports the service, as a whole and the queue service by name, as serving while its
+// database can be read, checking it every healthCheckPeriod until ctx is done.
+func (h *healthService) CheckDatabase(ctx context.Context, database db.Store) {
+	serving := healthpb.HealthCheckResponse_UNKNOWN
+	check := func() {
+		status := healthpb.HealthCheckResponse_SERVING
+		if _, err := database.SchemaVersion(); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			if serving != status {
+				log.Errorw("database is unhealthy", "error", err)
+			}
+		} else if serving == healthpb.HealthCheckResponse_NOT_SERVING {
+			log.Infow("database is healthy again")
+		}
+		serving = status
+		h.SetServingStatus("", status)
+		h.SetServingStatus(rpc.QueueService_ServiceDesc.ServiceName, status)
+	}
+
+	check()
+	ticker := time.NewTicker(healthCheckPeriod)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				check()
+			}
+		}
+	}()
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestHealthService_Drain(t *testing.T) {
+	store := db.NewMemory()
+	t.Cleanup(func() { store.Close() })
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	healthServer := newHealthService()
+	healthServer.CheckDatabase(ctx, store)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	watches := map[string]healthpb.Health_WatchClient{}
+	for _, service := range []string{"", rpc.QueueService_ServiceDesc.ServiceName, "unknown"} {
+		watch, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("unable to watch %q: %v", service, err)
+		}
+		expected := healthpb.HealthCheckResponse_SERVING
+		if service == "unknown" {
+			expected = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		resp, err := watch.Recv()
+		if err != nil {
+			t.Fatalf("unable to receive the status of %q: %v", service, err)
+		}
+		if resp.Status != expected {
+			t.Errorf("expected %q to be %v, got %v", service, expected, resp.Status)
+		}
+		watches[service] = watch
+	}
+
+	healthServer.Drain()
+
+	for service, watch := range watches {
+		if service != "unknown" {
+			resp, err := watch.Recv()
+			if err != nil {
+				t.Fatalf("expected %q to be reported as not serving before its watch ended, got %v", service, err)
+			}
+			if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+				t.Errorf("expected %q to be not serving, got %v", service, resp.Status)
+			}
+		}
+		if _, err := watch.Recv(); status.Code(err) != codes.Canceled {
+			t.Errorf("expected the watch of %q to end, got %v", service, err)
+		}
+	}
+
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("unable to check health: %v", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected the service to be not serving once drained, got %v", resp.Status)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"slices"
+	"testing"
+)
+
+// recorder returns interceptors which append their name to calls when entered and when left, and
+// which refuse the call if they are named in refuse.
+type recorder struct {
+	calls  []string
+	refuse string
+}
+
+var errRefused = errors.New("refused")
+
+func (r *recorder) unary(name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r.calls = append(r.calls, name+" "+info.FullMethod)
+		if name == r.refuse {
+			return nil, errRefused
+		}
+		defer func() { r.calls = append(r.calls, "/"+name) }()
+		return handler(ctx, req)
+	}
+}
+
+func (r *recorder) stream(name string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		r.calls = append(r.calls, name+" "+info.FullMethod)
+		if name == r.refuse {
+			return errRefused
+		}
+		defer func() { r.calls = append(r.calls, "/"+name) }()
+		return handler(srv, ss)
+	}
+}
+
+func TestChainUnary(t *testing.T) {
+	tests := map[string]struct {
+		refuse   string
+		expected []string
+		err      error
+	}{
+		"all called in order": {
+			expected: []string{"a m", "b m", "c m", "handler", "/c", "/b", "/a"},
+		},
+		"refused part way": {
+			refuse:   "b",
+			expected: []string{"a m", "b m", "/a"},
+			err:      errRefused,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{refuse: test.refuse}
+			chain := chainUnary(r.unary("a"), r.unary("b"), r.unary("c"))
+			res, err := chain(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "m"}, func(ctx context.Context, req any) (any, error) {
+				r.calls = append(r.calls, "handler")
+				return req, nil
+			})
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if err == nil && res != "req" {
+				t.Errorf("expected the handler's result, got %v", res)
+			}
+			if !slices.Equal(r.calls, test.expected) {
+				t.Errorf("expected calls %v, got %v", test.expected, r.calls)
+			}
+		})
+	}
+}
+
+func TestChainStream(t *testing.T) {
+	tests := map[string]struct {
+		refuse   string
+		expected []string
+		err      error
+	}{
+		"all called in order": {
+			expected: []string{"a m", "b m", "c m", "handler", "/c", "/b", "/a"},
+		},
+		"refused part way": {
+			refuse:   "b",
+			expected: []string{"a m", "b m", "/a"},
+			err:      errRefused,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{refuse: test.refuse}
+			chain := chainStream(r.stream("a"), r.stream("b"), r.stream("c"))
+			err := chain(nil, nil, &grpc.StreamServerInfo{FullMethod: "m"}, func(srv any, ss grpc.ServerStream) error {
+				r.calls = append(r.calls, "handler")
+				return nil
+			})
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if !slices.Equal(r.calls, test.expected) {
+				t.Errorf("expected calls %v, got %v", test.expected, r.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/auth"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/urfave/cli/v3"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	FlagRole = "role"
+	ArgName  = "name"
+)
+
+// keysCommand manages keys in the database directly, so that the first admin key can be created.
+// Once the service is running, keys are better managed through its RPCs.
+func keysCommand() *cli.Command {
+	nameArg := []cli.Argument{
+		&cli.StringArg{
+			Name:      ArgName,
+			UsageText: "The name of the key",
+		},
+	}
+	return &cli.Command{
+		Name:  "keys",
+		Usage: "Manage the API keys in the database. The service must not be running.",
+		Commands: []*cli.Command{
+			{
+				Name:      "create",
+				Usage:     "Create a key, and print its secret",
+				ArgsUsage: "<name>",
+				Arguments: nameArg,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  FlagRole,
+						Usage: "The key's role: read-only, client, worker or admin",
+						Value: "client",
+					},
+				},
+				Action: withKeys(func(cmd *cli.Command, keys *auth.Keys) error {
+					role, ok := auth.ParseRole(cmd.String(FlagRole))
+					if !ok {
+						return fmt.Errorf("unrecognised role %q", cmd.String(FlagRole))
+					}
+					_, secret, err := keys.Create(cmd.StringArg(ArgName), role)
+					if err != nil {
+						return err
+					}
+					fmt.Println(secret)
+					return nil
+				}),
+			},
+			{
+				Name:      "rotate",
+				Usage:     "Replace a key's secret, and print the new one",
+				ArgsUsage: "<name>",
+				Arguments: nameArg,
+				Action: withKeys(func(cmd *cli.Command, keys *auth.Keys) error {
+					_, secret, err := keys.Rotate(cmd.StringArg(ArgName))
+					if err != nil {
+						return err
+					}
+					fmt.Println(secret)
+					return nil
+				}),
+			},
+			{
+				Name:      "revoke",
+				Usage:     "Delete a key",
+				ArgsUsage: "<name>",
+				Arguments: nameArg,
+				Action: withKeys(func(cmd *cli.Command, keys *auth.Keys) error {
+					return keys.Revoke(cmd.StringArg(ArgName))
+				}),
+			},
+			{
+				Name:  "list",
+				Usage: "List the keys",
+				Action: withKeys(func(cmd *cli.Command, keys *auth.Keys) error {
+					list, err := keys.List()
+					if err != nil {
+						return err
+					}
+					w := tabwriter.NewWriter(os.Stdout, 5, 2, 1, ' ', 0)
+					defer w.Flush()
+					fmt.Fprintf(w, "Name\tRole\tCreated\tRotated\n")
+					for _, key := range list {
+						rotated := ""
+						if key.Rotated != nil {
+							rotated = key.Rotated.AsTime().Local().Format(time.DateTime)
+						}
+						fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key.Name, auth.RoleName(key.Role), key.Created.AsTime().Local().Format(time.DateTime), rotated)
+					}
+					return nil
+				}),
+			},
+		},
+	}
+}
+
+// withKeys opens the database for an action on its keys.
+func withKeys(action func(cmd *cli.Command, keys *auth.Keys) error) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		dbPath := cmd.String(FlagDB)
+		database, err := db.Open(cmd.String(FlagStore), dbPath)
+		if err != nil {
+			return err
+		}
+		defer database.Close()
+		if _, err := migrate(database, dbPath, false); err != nil {
+			return err
+		}
+		return action(cmd, &auth.Keys{DB: database})
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/urfave/cli/v3"
+	"time"
+)
+
+const FlagDryRun = "dry-run"
+
+func migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Bring the database up to the schema version of this build, and exit",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  FlagDryRun,
+				Usage: "Check the migrations which would be applied, without changing the database",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			dbPath := cmd.String(FlagDB)
+			database, err := db.Open(cmd.String(FlagStore), dbPath)
+			if err != nil {
+				return err
+			}
+			defer database.Close()
+
+			from, err := database.SchemaVersion()
+			if err != nil {
+				return err
+			}
+			dryRun := cmd.Bool(FlagDryRun)
+			applied, err := migrate(database, dbPath, dryRun)
+			if err != nil {
+				return err
+			}
+			if len(applied) == 0 {
+				fmt.Printf("schema version %d is up to date\n", from)
+				return nil
+			}
+			verb := "applied"
+			if dryRun {
+				verb = "would apply"
+			}
+			for _, m := range applied {
+				fmt.Printf("%s migration %d: %s\n", verb, m.Version, m.Description)
+			}
+			fmt.Printf("schema version %d -> %d\n", from, db.CurrentSchemaVersion())
+			return nil
+		},
+	}
+}
+
+// migrate applies the migrations the database is yet to have, having first copied it alongside
+// dbPath, to a file named for its current schema version.
+func migrate(database db.Store, dbPath string, dryRun bool) ([]db.Migration, error) {
+	version, err := database.SchemaVersion()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read schema version: %w", err)
+	}
+	backup := fmt.Sprintf("%s.v%d-%s.bak", dbPath, version, time.Now().Format("20060102150405"))
+	applied, err := database.Migrate(db.MigrateOptions{DryRun: dryRun, BackupPath: backup})
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate database: %w", err)
+	}
+	if len(applied) > 0 && !dryRun {
+		log.Infow("backed up database before migrating", "path", backup)
+	}
+	return applied, nil
+}
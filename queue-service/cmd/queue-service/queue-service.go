@@ -8,40 +8,91 @@ import (
 	"fmt"
 	"github.com/harryrose/godm/log"
 	"github.com/harryrose/godm/log/levels"
+	"github.com/harryrose/godm/log/tracing"
 	"github.com/harryrose/godm/queue-service"
 	"github.com/harryrose/godm/queue-service/auth"
 	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/events"
+	"github.com/harryrose/godm/queue-service/gateway"
+	"github.com/harryrose/godm/queue-service/janitor"
+	"github.com/harryrose/godm/queue-service/metrics"
 	"github.com/harryrose/godm/queue-service/rpc"
+	"github.com/harryrose/godm/queue-service/web"
+	"github.com/harryrose/godm/queue-service/workers"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	golog "log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 )
 
+// version is reported with the service's traces.  It can be set at build time with
+// -ldflags "-X main.version=...".
+var version = "dev"
+
 const (
-	keyLengthBytes = 16
+	keyLengthBytes         = 16
+	defaultShutdownTimeout = 30 * time.Second
 
 	FlagPort  = "port"
 	FlagDB    = "database"
 	FlagKey   = "key"
 	FlagQueue = "queue"
+	FlagStore = "store"
+
+	FlagArchiveDir    = "archive-dir"
+	FlagPruneInterval = "prune-interval"
+
+	FlagHTTPPort        = "http-port"
+	FlagHTTPAllowOrigin = "http-allow-origin"
+
+	FlagMetricsAddress = "metrics-address"
+
+	FlagTraceExporter = "trace-exporter"
+	FlagTraceEndpoint = "trace-endpoint"
+
+	FlagShutdownTimeout = "shutdown-timeout"
 
 	EnvPort  = "GODM_Q_PORT"
 	EnvDB    = "GODM_Q_DATABASE"
 	EnvKey   = "GODM_Q_KEY"
 	EnvQueue = "GODM_Q_QUEUE"
+	EnvStore = "GODM_Q_STORE"
+
+	EnvArchiveDir    = "GODM_Q_ARCHIVE_DIR"
+	EnvPruneInterval = "GODM_Q_PRUNE_INTERVAL"
+
+	EnvHTTPPort        = "GODM_Q_HTTP_PORT"
+	EnvHTTPAllowOrigin = "GODM_Q_HTTP_ALLOW_ORIGIN"
+
+	EnvMetricsAddress = "GODM_Q_METRICS_ADDRESS"
+
+	EnvTraceExporter = "GODM_Q_TRACE_EXPORTER"
+	EnvTraceEndpoint = "GODM_Q_TRACE_ENDPOINT"
+
+	EnvShutdownTimeout = "GODM_Q_SHUTDOWN_TIMEOUT"
 )
 
 func main() {
 	if err := log.Init(levels.Info); err != nil {
-		golog.Fatalf("unable to initialise logger: %w", err)
+		golog.Fatalf("unable to initialise logger: %v", err)
 	}
 
 	cmd := &cli.Command{
 		Name:  "queue-service",
 		Usage: "A queue service for GoDM",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.IntFlag{
 				Name:    FlagPort,
 				Aliases: []string{"p"},
@@ -52,14 +103,33 @@ func main() {
 			&cli.StringFlag{
 				Name:    FlagDB,
 				Aliases: []string{"d"},
-				Usage:   "The path to the database file",
+				Usage:   "The path to the database file, for stores which are held in one",
 				Value:   "queue.db",
 				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvDB)),
 			},
+			&cli.StringFlag{
+				Name:    FlagStore,
+				Aliases: []string{"s"},
+				Usage:   "The storage backend to use: " + strings.Join(db.Backends, ", "),
+				Value:   db.BackendBolt,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvStore)),
+			},
+			&cli.StringFlag{
+				Name:    FlagArchiveDir,
+				Usage:   "The directory to archive queues to when they are deleted",
+				Value:   "archive",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvArchiveDir)),
+			},
+			&cli.DurationFlag{
+				Name:    FlagPruneInterval,
+				Usage:   "How often to remove finished items which their queue's history retention policy no longer keeps",
+				Value:   janitor.DefaultInterval,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvPruneInterval)),
+			},
 			&cli.StringFlag{
 				Name:    FlagKey,
 				Aliases: []string{"k"},
-				Usage:   "The base64-encoded key to use for authentication. If not provided, a random key will be generated.",
+				Usage:   "A key which is accepted with the admin role, alongside the keys in the database. If not provided and the database holds no keys, a random key will be generated.",
 				Value:   "",
 				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvKey)),
 			},
@@ -70,19 +140,75 @@ func main() {
 				Value:   "default",
 				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvQueue)),
 			},
+			&cli.IntFlag{
+				Name:    FlagHTTPPort,
+				Usage:   "The port to serve the web interface and the API as JSON over HTTP on. If 0, neither is served",
+				Value:   0,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvHTTPPort)),
+			},
+			&cli.StringFlag{
+				Name:    FlagHTTPAllowOrigin,
+				Usage:   "The origin, or *, which browsers may call the HTTP API from",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvHTTPAllowOrigin)),
+			},
+			&cli.StringFlag{
+				Name:    FlagMetricsAddress,
+				Usage:   "The address, such as localhost:9012, to serve Prometheus metrics at /metrics on. Metrics aren't authenticated, so the address should only be reachable by the scraper. If not provided, metrics are not served",
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvMetricsAddress)),
+			},
+			&cli.StringFlag{
+				Name:    FlagTraceExporter,
+				Usage:   "Where to send traces: " + strings.Join(tracing.Exporters, ", "),
+				Value:   tracing.ExporterNone,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTraceExporter)),
+			},
+			&cli.StringFlag{
+				Name:        FlagTraceEndpoint,
+				Usage:       "The address or URL of the OTLP collector to send traces to",
+				DefaultText: "localhost:4317",
+				Sources:     cli.NewValueSourceChain(cli.EnvVar(EnvTraceEndpoint)),
+			},
+			&cli.DurationFlag{
+				Name:    FlagShutdownTimeout,
+				Usage:   "How long to wait for calls in progress to finish on SIGINT or SIGTERM, before ending them. A second SIGINT or SIGTERM exits without waiting",
+				Value:   defaultShutdownTimeout,
+				Sources: cli.NewValueSourceChain(cli.EnvVar(EnvShutdownTimeout)),
+			},
+		}, tlsFlags()...),
+		Commands: []*cli.Command{
+			migrateCommand(),
+			backupCommand(),
+			restoreCommand(),
+			importCommand(),
+			keysCommand(),
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
-			key := cmd.String(FlagKey)
-			if len(key) == 0 {
-				// generate a random key
-				buf := make([]byte, keyLengthBytes)
-				if _, err := rand.Read(buf); err != nil {
-					return fmt.Errorf("unable to read random data: %w", err)
-				}
-				key = base64.RawURLEncoding.EncodeToString(buf)
-				log.Infow("generated key", "key", key)
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+				Exporter: cmd.String(FlagTraceExporter),
+				Endpoint: cmd.String(FlagTraceEndpoint),
+				Service:  "queue-service",
+				Version:  version,
+			})
+			if err != nil {
+				return err
 			}
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					log.Warnw("unable to flush traces", "error", err)
+				}
+			}()
 
+			tlsConfig, err := serverTLSConfig(cmd)
+			if err != nil {
+				return err
+			}
+			creds := insecure.NewCredentials()
+			if tlsConfig != nil {
+				creds = credentials.NewTLS(tlsConfig)
+			}
 			port := cmd.Int(FlagPort)
 			listenString := fmt.Sprintf(":%d", port)
 			listener, err := net.Listen("tcp", listenString)
@@ -90,28 +216,142 @@ func main() {
 				return err
 			}
 			dbPath := cmd.String(FlagDB)
-			database, err := db.NewBolt(dbPath)
+			database, err := db.Open(cmd.String(FlagStore), dbPath)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := database.Close(); err != nil {
+					log.Errorw("error closing database", "error", err)
+					return
+				}
+				log.Infow("closed database")
+			}()
+			applied, err := migrate(database, dbPath, false)
 			if err != nil {
 				return err
 			}
-			svc := queue_service.Service{DB: database}
+			for _, m := range applied {
+				log.Infow("applied migration", "version", m.Version, "description", m.Description)
+			}
+			apiKeys := &auth.Keys{DB: database, Bootstrap: cmd.String(FlagKey)}
+			if err := apiKeys.Load(); err != nil {
+				return err
+			}
+			stored, err := apiKeys.List()
+			if err != nil {
+				return err
+			}
+			if apiKeys.Bootstrap == "" && len(stored) == 0 {
+				// without any keys the service can't be used, so generate a random admin key
+				buf := make([]byte, keyLengthBytes)
+				if _, err := rand.Read(buf); err != nil {
+					return fmt.Errorf("unable to read random data: %w", err)
+				}
+				apiKeys.Bootstrap = base64.RawURLEncoding.EncodeToString(buf)
+				log.Infow("generated key", "key", apiKeys.Bootstrap)
+			}
+
+			registry := &workers.Registry{}
+			registry.CleanLoopAsync(ctx)
+			historyJanitor := &janitor.Janitor{DB: database, Interval: cmd.Duration(FlagPruneInterval)}
+			janitorCtx, stopJanitor := context.WithCancel(ctx)
+			janitorDone := historyJanitor.PruneLoopAsync(janitorCtx)
+			// this runs before the database is closed, which was deferred first, so that a prune
+			// in progress finishes before it is
+			defer func() {
+				stopJanitor()
+				<-janitorDone
+			}()
+			broker := &events.Broker{}
+			svc := queue_service.Service{DB: database, Events: broker, Workers: registry, Keys: apiKeys, ArchiveDir: cmd.String(FlagArchiveDir)}
 
 			queue := cmd.String(FlagQueue)
-			_, err = database.CreateQueue(queue)
+			_, err = database.CreateQueue(queue, nil)
 			if err != nil && !errors.As(err, &db.ErrConflict{}) {
 				return err
 			}
 
+			prometheus.MustRegister(&metrics.QueueCollector{DB: database})
+			unaryInterceptor := chainUnary(otelgrpc.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), auth.AuthorizationInterceptor(apiKeys))
+			streamInterceptor := chainStream(otelgrpc.StreamServerInterceptor(), metrics.StreamServerInterceptor(), auth.AuthorizationStreamInterceptor(apiKeys))
 			grpcServer := grpc.NewServer(
-				grpc.UnaryInterceptor(auth.AuthorizationInterceptor(key)),
+				grpc.Creds(creds),
+				grpc.UnaryInterceptor(unaryInterceptor),
+				grpc.StreamInterceptor(streamInterceptor),
 			)
+			healthServer := newHealthService()
+			healthpb.RegisterHealthServer(grpcServer, healthServer)
+			healthServer.CheckDatabase(ctx, database)
 
-			log.Infow("listening", "address", listenString)
+			errs := make(chan error, 2)
+			var httpServer *http.Server
+			if httpPort := cmd.Int(FlagHTTPPort); httpPort != 0 {
+				httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", httpPort))
+				if err != nil {
+					return err
+				}
+				mux := http.NewServeMux()
+				mux.Handle(gateway.PathPrefix, &gateway.Handler{
+					Service:           &svc,
+					UnaryInterceptor:  unaryInterceptor,
+					StreamInterceptor: streamInterceptor,
+					AllowOrigin:       cmd.String(FlagHTTPAllowOrigin),
+				})
+				mux.Handle("/", web.Handler())
+				httpServer = &http.Server{Handler: mux}
+				if tlsConfig != nil {
+					httpServer.TLSConfig = tlsConfig.Clone()
+				}
+				log.Infow("serving http", "address", httpListener.Addr().String(), "tls", tlsConfig != nil)
+				go func() {
+					var err error
+					if tlsConfig != nil {
+						err = httpServer.ServeTLS(httpListener, "", "")
+					} else {
+						err = httpServer.Serve(httpListener)
+					}
+					if !errors.Is(err, http.ErrServerClosed) {
+						errs <- fmt.Errorf("error serving http: %w", err)
+					}
+				}()
+			}
+
+			var metricsServer *http.Server
+			if address := cmd.String(FlagMetricsAddress); address != "" {
+				// metrics are served apart from the API, which needs a key, so that scrapers don't
+				// need one and the metrics can be kept off public interfaces
+				metricsListener, err := net.Listen("tcp", address)
+				if err != nil {
+					return fmt.Errorf("unable to listen for metrics: %w", err)
+				}
+				mux := http.NewServeMux()
+				mux.Handle("/metrics", promhttp.Handler())
+				metricsServer = &http.Server{Handler: mux}
+				log.Infow("serving metrics", "address", metricsListener.Addr().String())
+				go func() {
+					if err := metricsServer.Serve(metricsListener); !errors.Is(err, http.ErrServerClosed) {
+						log.Errorw("error serving metrics", "error", err)
+					}
+				}()
+			}
+
+			log.Infow("listening", "address", listenString, "tls", tlsConfig != nil)
 			rpc.RegisterQueueServiceServer(grpcServer, &svc)
-			if err := grpcServer.Serve(listener); err != nil {
-				return fmt.Errorf("error serving grpc: %w", err)
+			go func() {
+				if err := grpcServer.Serve(listener); err != nil {
+					errs <- fmt.Errorf("error serving grpc: %w", err)
+				}
+			}()
+
+			var serveErr error
+			select {
+			case serveErr = <-errs:
+			case <-ctx.Done():
 			}
-			return nil
+			forceExitOnSignal()
+			shutdown(grpcServer, httpServer, metricsServer, healthServer, broker, cmd.Duration(FlagShutdownTimeout))
+			return serveErr
 		},
 	}
 
@@ -121,3 +361,52 @@ func main() {
 	}
 	log.Infow("exited")
 }
+
+// forceExitOnSignal exits straight away on a SIGINT or SIGTERM from now on, so that a service
+// which is taking too long to shut down can be stopped without waiting for the timeout.
+func forceExitOnSignal() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Warnw("exiting without waiting for calls in progress", "signal", sig.String())
+		os.Exit(1)
+	}()
+}
+
+// shutdown stops the service accepting calls, and waits for those in progress to finish until
+// the timeout, after which they are ended.  Health checks report the service as not serving
+// while it drains, and watchers are told to reconnect elsewhere.  Metrics are served until the
+// calls have finished, so that the drain can be watched.
+func shutdown(grpcServer *grpc.Server, httpServer, metricsServer *http.Server, healthServer *healthService, broker *events.Broker, timeout time.Duration) {
+	log.Infow("shutting down", "timeout", timeout.String())
+	healthServer.Drain()
+	broker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	if httpServer != nil {
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Warnw("ending http requests still in progress", "error", err)
+			httpServer.Close()
+		}
+	}
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warnw("ending calls still in progress")
+		grpcServer.Stop()
+	}
+	if metricsServer != nil {
+		// a scrape in progress is given no longer than the calls were
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			metricsServer.Close()
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/harryrose/godm/log/clienttls"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/credentials"
+	"os"
+)
+
+const (
+	FlagTLSCert              = "tls-cert"
+	FlagTLSKey               = "tls-key"
+	FlagTLSClientCA          = "tls-client-ca"
+	FlagTLSRequireClientCert = "tls-require-client-cert"
+	FlagTLS                  = "tls"
+	FlagTLSCA                = "tls-ca"
+
+	EnvTLSCert              = "GODM_Q_TLS_CERT"
+	EnvTLSKey               = "GODM_Q_TLS_KEY"
+	EnvTLSClientCA          = "GODM_Q_TLS_CLIENT_CA"
+	EnvTLSRequireClientCert = "GODM_Q_TLS_REQUIRE_CLIENT_CERT"
+)
+
+func tlsFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagTLSCert,
+			Local:   true,
+			Usage:   "The path to a PEM certificate to serve TLS with. If not provided, connections are not encrypted",
+			Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSCert)),
+		},
+		&cli.StringFlag{
+			Name:    FlagTLSKey,
+			Local:   true,
+			Usage:   "The path to the PEM private key of the TLS certificate",
+			Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSKey)),
+		},
+		&cli.StringFlag{
+			Name:    FlagTLSClientCA,
+			Local:   true,
+			Usage:   "The path to PEM CA certificates which client certificates are verified against. A client with a verified certificate is given the role of the key named after the certificate's common name",
+			Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSClientCA)),
+		},
+		&cli.BoolFlag{
+			Name:    FlagTLSRequireClientCert,
+			Local:   true,
+			Usage:   "Refuse connections from clients without a verified certificate",
+			Sources: cli.NewValueSourceChain(cli.EnvVar(EnvTLSRequireClientCert)),
+		},
+	}
+}
+
+// clientTLSFlags are the flags of the subcommands which call the service, named as the CLI's
+// are.  The flags the service is served with are local to its own command, so --tls-cert and
+// --tls-key given to one of these subcommands are the client certificate rather than the
+// server's.
+func clientTLSFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  FlagTLS,
+			Usage: "Connect with TLS, verifying the service's certificate against the system's CAs unless --" + FlagTLSCA + " is given. Implied by the other TLS flags",
+		},
+		&cli.StringFlag{
+			Name:  FlagTLSCA,
+			Usage: "The path to PEM CA certificates to verify the service's certificate with",
+		},
+		&cli.StringFlag{
+			Name:  FlagTLSCert,
+			Usage: "The path to a PEM client certificate to present to the service",
+		},
+		&cli.StringFlag{
+			Name:  FlagTLSKey,
+			Usage: "The path to the PEM private key of the client certificate",
+		},
+	}
+}
+
+// serverTLSConfig returns the TLS configuration the service listens with, or nil if connections
+// aren't to be encrypted.
+func serverTLSConfig(cmd *cli.Command) (*tls.Config, error) {
+	certFile, keyFile, clientCAFile := cmd.String(FlagTLSCert), cmd.String(FlagTLSKey), cmd.String(FlagTLSClientCA)
+	if certFile == "" && keyFile == "" {
+		if clientCAFile != "" || cmd.Bool(FlagTLSRequireClientCert) {
+			return nil, errors.New("client certificates can only be verified when a TLS certificate and key are given")
+		}
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load TLS certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile != "" {
+		pool, err := loadCertPool(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+		if cmd.Bool(FlagTLSRequireClientCert) {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else if cmd.Bool(FlagTLSRequireClientCert) {
+		return nil, fmt.Errorf("--%s requires --%s", FlagTLSRequireClientCert, FlagTLSClientCA)
+	}
+	return config, nil
+}
+
+// clientCredentials returns the transport credentials the subcommands which call the service
+// connect with.  The connection is encrypted if --tls is set, or if any of the TLS files are
+// given.  A client certificate is presented if one is given, which the service may accept in
+// place of a key.
+func clientCredentials(cmd *cli.Command) (credentials.TransportCredentials, error) {
+	return clienttls.Credentials(cmd.Bool(FlagTLS), cmd.String(FlagTLSCA), cmd.String(FlagTLSCert), cmd.String(FlagTLSKey))
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA certificates: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bs) {
+		return nil, fmt.Errorf("no certificates found in %v", path)
+	}
+	return pool, nil
+}
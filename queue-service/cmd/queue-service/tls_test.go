@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/levels"
+	"github.com/harryrose/godm/queue-service/auth"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	if err := log.Init(levels.Error); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// testCA signs certificates for the TLS tests, writing them to a temporary directory.
+type testCA struct {
+	t    *testing.T
+	dir  string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	// certFile is the path to the CA's PEM certificate.
+	certFile string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	ca := &testCA{t: t, dir: t.TempDir()}
+	ca.cert, ca.key, ca.certFile, _ = ca.issue("ca", &x509.Certificate{
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	return ca
+}
+
+// issue signs a certificate with the given common name and template, by the CA if there is one
+// or by itself if not, and returns the paths to its PEM certificate and key.
+func (ca *testCA) issue(name string, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	ca.t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.Subject = pkix.Name{CommonName: name}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parent, signer := template, key
+	if ca.cert != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(ca.dir, name+".pem"), filepath.Join(ca.dir, name+"-key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		ca.t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		ca.t.Fatal(err)
+	}
+	return cert, key, certFile, keyFile
+}
+
+// issueServer signs a certificate for the service listening on the loopback address.
+func (ca *testCA) issueServer() (string, string) {
+	_, _, certFile, keyFile := ca.issue("server", &x509.Certificate{
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return certFile, keyFile
+}
+
+// issueClient signs a client certificate with the given common name.
+func (ca *testCA) issueClient(name string) (string, string) {
+	_, _, certFile, keyFile := ca.issue(name, &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return certFile, keyFile
+}
+
+// parseCommand returns a command with the given flags, parsed from the given arguments.
+func parseCommand(t *testing.T, flags []cli.Flag, args ...string) *cli.Command {
+	t.Helper()
+	var parsed *cli.Command
+	cmd := &cli.Command{
+		Name:  "test",
+		Flags: flags,
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			parsed = cmd
+			return nil
+		},
+	}
+	if err := cmd.Run(context.Background(), append([]string{"test"}, args...)); err != nil {
+		t.Fatalf("unable to parse %v: %v", args, err)
+	}
+	return parsed
+}
+
+func TestServerTLSConfig_Invalid(t *testing.T) {
+	ca := newTestCA(t)
+	certFile, keyFile := ca.issueServer()
+	tests := map[string][]string{
+		"client CA without a certificate":          {"--" + FlagTLSClientCA, ca.certFile},
+		"required client certificate without TLS":  {"--" + FlagTLSRequireClientCert},
+		"required client certificate without a CA": {"--" + FlagTLSCert, certFile, "--" + FlagTLSKey, keyFile, "--" + FlagTLSRequireClientCert},
+		"certificate without a key":                {"--" + FlagTLSCert, certFile},
+		"client CA which holds no certificates":    {"--" + FlagTLSCert, certFile, "--" + FlagTLSKey, keyFile, "--" + FlagTLSClientCA, keyFile},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := serverTLSConfig(parseCommand(t, tlsFlags(), args...)); err == nil {
+				t.Errorf("expected an error for %v", args)
+			}
+		})
+	}
+}
+
+func TestServerTLSConfig_ClientCertificates(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issueServer()
+	readerCert, readerKey := ca.issueClient("reader")
+	strangerCert, strangerKey := ca.issueClient("stranger")
+
+	config, err := serverTLSConfig(parseCommand(t, tlsFlags(),
+		"--"+FlagTLSCert, serverCert,
+		"--"+FlagTLSKey, serverKey,
+		"--"+FlagTLSClientCA, ca.certFile,
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	store := db.NewMemory()
+	t.Cleanup(func() { store.Close() })
+	apiKeys := &auth.Keys{DB: store}
+	if _, _, err := apiKeys.Create("reader", db.Role_ROLE_READ_ONLY); err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(config)),
+		grpc.UnaryInterceptor(auth.AuthorizationInterceptor(apiKeys)),
+	)
+	rpc.RegisterQueueServiceServer(server, rpc.UnimplementedQueueServiceServer{})
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	tests := []struct {
+		name string
+		args []string
+		// claim is the code expected from ClaimNextItem, which a read only key may not call
+		list, claim codes.Code
+	}{
+		{
+			// calls which are allowed reach the service, which implements nothing
+			name:  "certificate named after a key",
+			args:  []string{"--" + FlagTLSCert, readerCert, "--" + FlagTLSKey, readerKey},
+			list:  codes.Unimplemented,
+			claim: codes.PermissionDenied,
+		},
+		{
+			name:  "certificate named after no key",
+			args:  []string{"--" + FlagTLSCert, strangerCert, "--" + FlagTLSKey, strangerKey},
+			list:  codes.Unauthenticated,
+			claim: codes.Unauthenticated,
+		},
+		{
+			name:  "no certificate",
+			args:  nil,
+			list:  codes.Unauthenticated,
+			claim: codes.Unauthenticated,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creds, err := clientCredentials(parseCommand(t, clientTLSFlags(), append([]string{"--" + FlagTLSCA, ca.certFile}, test.args...)...))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(creds))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			client := rpc.NewQueueServiceClient(conn)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err = client.ListQueues(ctx, &rpc.ListQueuesInput{})
+			if got := status.Code(err); got != test.list {
+				t.Errorf("expected ListQueues to return %v, got %v", test.list, err)
+			}
+			_, err = client.ClaimNextItem(ctx, &rpc.ClaimNextItemInput{})
+			if got := status.Code(err); got != test.claim {
+				t.Errorf("expected ClaimNextItem to return %v, got %v", test.claim, err)
+			}
+		})
+	}
+}
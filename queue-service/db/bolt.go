@@ -1,32 +1,29 @@
 package db
 
 import (
-	"cmp"
-	"crypto/rand"
 	"encoding/binary"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/golang/protobuf/proto"
-	"github.com/golang/protobuf/ptypes/timestamp"
-	"github.com/harryrose/godm/queue-service/queue"
 	bolt "go.etcd.io/bbolt"
-	"google.golang.org/protobuf/types/known/timestamppb"
-	"log"
-	"regexp"
-	"strings"
+	"io"
 	"time"
 )
 
 const (
-	QueueBucket     = "queues"
-	QueueMetaKey    = "meta"
-	ItemsBucket     = "items"
-	FinishedBucket  = "finished"
-	idSeparator     = ":"
-	claimTTL        = time.Second * 30
-	MaxPageSize     = 100
-	DefaultPageSize = 50
+	QueueBucket    = "queues"
+	QueueMetaKey   = "meta"
+	ItemsBucket    = "items"
+	FinishedBucket = "finished"
+	// FinishedIndexBucket maps the ids of finished items to their keys in FinishedBucket.
+	FinishedIndexBucket = "finished-index"
+	// SchemaBucket holds the schema version of the database under SchemaVersionKey.
+	SchemaBucket     = "schema"
+	SchemaVersionKey = "version"
+	// KeysBucket holds the API keys, by name.
+	KeysBucket = "keys"
+	// CategoriesBucket holds the categories' settings, by name.
+	CategoriesBucket = "categories"
 )
 
 func NewBolt(path string) (*Bolt, error) {
@@ -37,13 +34,47 @@ func NewBolt(path string) (*Bolt, error) {
 	out := &Bolt{
 		db: db,
 	}
+	out.store.backend = out
+	if err := out.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	return out, nil
 }
 
+// Bolt is a store held in a bbolt database.  Each queue is a bucket within QueueBucket, holding
+// the queue's record under QueueMetaKey, and buckets for its active and finished items.
 type Bolt struct {
-	now func() time.Time
-	db  *bolt.DB
+	store
+	db *bolt.DB
+}
+
+func (b *Bolt) Close() error {
+	return b.db.Close()
+}
+
+func (b *Bolt) view(fn func(tx txn) error) error {
+	defer observeTransaction(BackendBolt, transactionView, time.Now())
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTxn{tx})
+	})
+}
+
+func (b *Bolt) update(fn func(tx txn) error) error {
+	defer observeTransaction(BackendBolt, transactionUpdate, time.Now())
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTxn{tx})
+	})
+}
+
+// snapshot writes the database file as it stands at the start of a read transaction, so that
+// it can be taken while the database is in use.
+func (b *Bolt) snapshot(w io.Writer) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
 }
 
 func ensureBucket(parent interface {
@@ -60,423 +91,480 @@ func ensureBucket(parent interface {
 	return buc, nil
 }
 
-func (b *Bolt) CreateQueue(name string) (*Queue, error) {
-	var out Queue
-	err := b.db.Update(func(tx *bolt.Tx) error {
-		queues, err := ensureBucket(tx, QueueBucket)
-		if err != nil {
-			return fmt.Errorf("error creating bucket %v: %w", QueueBucket, err)
-		}
-		key := sanitiseQueueName(name)
-
-		queuebucket, err := queues.CreateBucket([]byte(key))
-		if err != nil {
-			if errors.Is(err, bolt.ErrBucketExists) {
-				return ErrConflict{}
-			}
-			return fmt.Errorf("error creating queue bucket, %v: %w", key, err)
-		}
+type boltTxn struct {
+	tx *bolt.Tx
+}
 
-		items, err := ensureBucket(queuebucket, ItemsBucket)
-		if err != nil {
-			return fmt.Errorf("error creating items bucket: %w", err)
-		}
+func (t boltTxn) schemaVersion() (int, error) {
+	schema := t.tx.Bucket([]byte(SchemaBucket))
+	if schema == nil {
+		return 0, nil
+	}
+	bs := schema.Get([]byte(SchemaVersionKey))
+	if len(bs) != 8 {
+		return 0, nil
+	}
+	return int(binary.BigEndian.Uint64(bs)), nil
+}
 
-		_, err = ensureBucket(queuebucket, FinishedBucket)
-		if err != nil {
-			return fmt.Errorf("error creating abandoned bucket: %w", err)
-		}
+func (t boltTxn) setSchemaVersion(version int) error {
+	schema, err := ensureBucket(t.tx, SchemaBucket)
+	if err != nil {
+		return fmt.Errorf("error creating bucket %v: %w", SchemaBucket, err)
+	}
+	return schema.Put([]byte(SchemaVersionKey), binary.BigEndian.AppendUint64(nil, uint64(version)))
+}
 
-		if err := items.SetSequence(10); err != nil {
-			return fmt.Errorf("error setting sequence: %w", err)
-		}
+func (t boltTxn) createQueue(meta *Queue) error {
+	queues, err := ensureBucket(t.tx, QueueBucket)
+	if err != nil {
+		return fmt.Errorf("error creating bucket %v: %w", QueueBucket, err)
+	}
 
-		out.Id = key
-		out.Name = name
-		out.Timestamp = &timestamp.Timestamp{
-			Seconds: time.Now().Unix(),
+	queuebucket, err := queues.CreateBucket([]byte(meta.Id))
+	if err != nil {
+		if errors.Is(err, bolt.ErrBucketExists) {
+			return ErrConflict{}
 		}
+		return fmt.Errorf("error creating queue bucket, %v: %w", meta.Id, err)
+	}
 
-		marsh, err := proto.Marshal(&out)
-		if err != nil {
-			return fmt.Errorf("error marshalling queue object: %w", err)
-		}
-		if err := queuebucket.Put([]byte(QueueMetaKey), marsh); err != nil {
-			return fmt.Errorf("error putting queue object: %w", err)
-		}
+	items, err := ensureBucket(queuebucket, ItemsBucket)
+	if err != nil {
+		return fmt.Errorf("error creating items bucket: %w", err)
+	}
 
-		return nil
-	})
-	return &out, err
-}
+	_, err = ensureBucket(queuebucket, FinishedBucket)
+	if err != nil {
+		return fmt.Errorf("error creating abandoned bucket: %w", err)
+	}
 
-func (b *Bolt) ListQueues() ([]string, error) {
-	var out []string
-	err := b.db.View(func(tx *bolt.Tx) error {
-		queues, err := ensureBucket(tx, QueueBucket)
-		if err != nil {
-			return fmt.Errorf("error listing queues: %w", err)
-		}
-		return queues.ForEachBucket(func(name []byte) error {
-			out = append(out, string(name))
-			return nil
-		})
-	})
-	return out, err
+	// as before the other backends were added, ids start after 10
+	if err := items.SetSequence(firstSequence); err != nil {
+		return fmt.Errorf("error setting sequence: %w", err)
+	}
+	return t.putQueueMeta(meta)
 }
 
-func (b *Bolt) EnqueueItem(queue, source, destination, category string) (string, error) {
-	var out string
-	err := b.db.Update(func(tx *bolt.Tx) error {
-		items, err := b.getQueueItemsBucket(tx, queue)
-		if err != nil {
-			return err
-		}
-		id, err := items.NextSequence()
-		if err != nil {
-			return fmt.Errorf("error getting next sequence: %w", err)
-		}
-		q := sanitiseQueueName(queue)
-		itemID := fmt.Sprintf("%s"+idSeparator+"%020d", q, id)
-		out = itemID
-		var item Item
-		item.Id = itemID
-		item.Source = &Target{
-			Url: source,
-		}
-		item.Destination = &Target{
-			Url: destination,
-		}
-		item.Category = &Category{
-			Id: category,
-		}
-
-		ibs, err := proto.Marshal(&item)
-		if err != nil {
-			return fmt.Errorf("error marshalling item: %w", err)
-		}
-		if err := items.Put([]byte(itemID), ibs); err != nil {
-			return fmt.Errorf("error adding item: %w", err)
-		}
+func (t boltTxn) queueKeys() ([]string, error) {
+	var out []string
+	queues := t.tx.Bucket([]byte(QueueBucket))
+	if queues == nil {
+		return nil, nil
+	}
+	err := queues.ForEachBucket(func(name []byte) error {
+		out = append(out, string(name))
 		return nil
 	})
 	return out, err
 }
 
-func (b *Bolt) SetItemState(id string, state queue.ItemState_State, bytesDownloaded uint64, totalSizeBytes uint64, err error) error {
-	switch state {
-	case queue.ItemState_ITEM_STATE_UNSPECIFIED:
-		return fmt.Errorf("state was not specified")
-
-	case queue.ItemState_ITEM_STATE_FAILED:
-		return b.FailItem(id, bytesDownloaded, totalSizeBytes, err)
-
-	case queue.ItemState_ITEM_STATE_COMPLETE:
-		return b.CompleteItem(id, totalSizeBytes)
-
-	case queue.ItemState_ITEM_STATE_DOWNLOADING:
-		return b.SetProgress(id, bytesDownloaded, totalSizeBytes)
-
-	default:
-		return fmt.Errorf("unrecognised state: %v, %v", int32(state), queue.ItemState_State_name[int32(state)])
+func (t boltTxn) deleteQueue(q string) error {
+	queues := t.tx.Bucket([]byte(QueueBucket))
+	if queues == nil {
+		return ErrNotFound{}
 	}
-
-}
-
-func (b *Bolt) SetProgress(id string, bytesDownloaded uint64, totalSizeBytes uint64) error {
-	return b.db.Update(func(tx *bolt.Tx) error {
-		q, err := queueKeyFromItemID(id)
-		if err != nil {
-			return ErrInvalid{}
-		}
-
-		items, err := b.getQueueItemsBucket(tx, q)
-		if err != nil {
-			return err
-		}
-
-		bs := items.Get([]byte(id))
-		if len(bs) == 0 {
+	if err := queues.DeleteBucket([]byte(q)); err != nil {
+		if errors.Is(err, bolt.ErrBucketNotFound) {
 			return ErrNotFound{}
 		}
+		return fmt.Errorf("error deleting queue bucket, %v: %w", q, err)
+	}
+	return nil
+}
 
-		var item Item
-		if err := proto.Unmarshal(bs, &item); err != nil {
-			return fmt.Errorf("error unmarshalling item: %w", err)
-		}
-		item.TotalSizeBytes = totalSizeBytes
-		item.DownloadedBytes = bytesDownloaded
-		item.ClaimExpiry = timestamppb.New(time.Now().Add(claimTTL))
+func (t boltTxn) getQueueMeta(q string) (*Queue, error) {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return nil, err
+	}
+	var out Queue
+	if err := proto.Unmarshal(queueBucket.Get([]byte(QueueMetaKey)), &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling queue object: %w", err)
+	}
+	return &out, nil
+}
 
-		bs, err = proto.Marshal(&item)
-		if err != nil {
-			return fmt.Errorf("error marshalling item: %w", err)
-		}
-		if err := items.Put([]byte(id), bs); err != nil {
-			return fmt.Errorf("error storing update: %w", err)
-		}
-		return nil
-	})
+func (t boltTxn) putQueueMeta(meta *Queue) error {
+	queueBucket, err := t.queueBucket(meta.Id)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue object: %w", err)
+	}
+	if err := queueBucket.Put([]byte(QueueMetaKey), bs); err != nil {
+		return fmt.Errorf("error putting queue object: %w", err)
+	}
+	return nil
 }
 
-func (b *Bolt) CompleteItem(id string, totalSizeBytes uint64) error {
-	return b.moveItemToFinished(id, totalSizeBytes, totalSizeBytes, FinishedItem_ITEM_STATE_SUCCESS, "")
+func (t boltTxn) nextSequence(q string) (uint64, error) {
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return 0, err
+	}
+	return items.NextSequence()
 }
 
-func (b *Bolt) FailItem(id string, downloadedBytes, totalSizeBytes uint64, err error) error {
-	return b.moveItemToFinished(id, downloadedBytes, totalSizeBytes, FinishedItem_ITEM_STATE_FAILED, err.Error())
+// nextLease uses the queue bucket's own sequence, which is otherwise unused.
+func (t boltTxn) nextLease(q string) (uint64, error) {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return 0, err
+	}
+	return queueBucket.NextSequence()
 }
 
-func (b *Bolt) CancelItem(id string) error {
-	return b.moveItemToFinished(id, 0, 0, FinishedItem_ITEM_STATE_CANCELLED, "cancelled by user")
+func (t boltTxn) sequences(q string) (uint64, uint64, error) {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return 0, 0, err
+	}
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return 0, 0, err
+	}
+	return items.Sequence(), queueBucket.Sequence(), nil
 }
 
-func (b *Bolt) GetQueueItems(queueID string, startKey string, pageSize uint) (queueItems []Item, nextKey string, err error) {
-	return getQueueItems(b, func() *Item {
-		return &Item{}
-	}, func(t *Item) Item {
-		return *t
-	}, queueID, ItemsBucket, startKey, pageSize)
+func (t boltTxn) setSequences(q string, item uint64, lease uint64) error {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return err
+	}
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return err
+	}
+	if err := items.SetSequence(item); err != nil {
+		return err
+	}
+	return queueBucket.SetSequence(lease)
 }
 
-func (b *Bolt) GetFinishedItems(queueID string, startKey string, pageSize uint) (queueItems []FinishedItem, nextKey string, err error) {
-	return getQueueItems(b, func() *FinishedItem {
-		return &FinishedItem{}
-	}, func(t *FinishedItem) FinishedItem {
-		return *t
-	}, queueID, FinishedBucket, startKey, pageSize)
+func (t boltTxn) getItem(q string, id string) (*Item, error) {
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return nil, err
+	}
+	bs := items.Get([]byte(id))
+	if bs == nil {
+		return nil, ErrNotFound{}
+	}
+	out := &Item{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling item: %w", err)
+	}
+	return out, nil
 }
 
-func (b *Bolt) ClearHistory(queueID string) error {
-	return b.db.Update(func(tx *bolt.Tx) error {
-		fin, err := b.getFinishedItemsBucket(tx, queueID)
-		if err != nil {
-			return err
+func (t boltTxn) items(q string) ([]*Item, error) {
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Item
+	err = items.ForEach(func(k, v []byte) error {
+		item := &Item{}
+		if err := proto.Unmarshal(v, item); err != nil {
+			return fmt.Errorf("error unmarshalling item %v: %w", string(k), err)
 		}
-		return fin.ForEach(func(k, _ []byte) error {
-			if err := fin.Delete(k); err != nil {
-				log.Printf("error deleting %v: %v\n", string(k), err)
-			}
-			return nil
-		})
+		out = append(out, item)
+		return nil
 	})
+	return out, err
 }
 
-func getQueueItems[T proto.Message, V any](b *Bolt, newT func() T, tToV func(T) V, queueID string, itemsName string, startKey string, pageSize uint) (queueItems []V, nextKey string, err error) {
-	pageSize = min(defaultIfEmpty(DefaultPageSize, pageSize), MaxPageSize)
-	queueItems = make([]V, 0, pageSize)
-	nextKey = ""
-	err = b.db.View(func(tx *bolt.Tx) error {
-		items, err := b.getQueueInnerBucket(tx, queueID, itemsName)
-		if err != nil {
-			return fmt.Errorf("queue %s: %w", queueID, err)
-		}
-
-		limitReached := errors.New("limit reached")
-		itemCount := uint(0)
-		err = items.ForEach(func(k, v []byte) error {
-			sk := string(k)
-			if startKey != "" && sk < startKey {
-				return nil
-			}
-			if itemCount >= pageSize {
-				nextKey = sk
-				return limitReached
-			}
-			item := newT()
-			if err := proto.Unmarshal(v, item); err != nil {
-				return fmt.Errorf("unmarshalling item: %w", err)
-			}
-			queueItems = append(queueItems, tToV(item))
-			return nil
-		})
-
-		switch {
-		case errors.Is(err, limitReached):
-			return nil
-		case err == nil:
-			return nil
-		default:
-			return err
-		}
-	})
-	return
+func (t boltTxn) putItem(q string, item *Item) error {
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("error marshalling item: %w", err)
+	}
+	if err := items.Put([]byte(item.Id), bs); err != nil {
+		return fmt.Errorf("error storing item: %w", err)
+	}
+	return nil
 }
 
-func (b *Bolt) ClaimNextItem(queue string) (*Item, error) {
-	var nextItem Item
-	var nextItemKey []byte
-	itemFound := errors.New("found")
+func (t boltTxn) deleteItem(q string, id string) error {
+	items, err := t.innerBucket(q, ItemsBucket)
+	if err != nil {
+		return err
+	}
+	return items.Delete([]byte(id))
+}
 
-	err := b.db.Update(func(tx *bolt.Tx) error {
-		items, err := b.getQueueItemsBucket(tx, queue)
-		if err != nil {
-			return err
-		}
-		err = items.ForEach(func(k, v []byte) error {
-			var item Item
-			if err := proto.Unmarshal(v, &item); err != nil {
-				return fmt.Errorf("error unmarshalling item: %w", err)
-			}
-
-			if item.ClaimExpiry.AsTime().Before(time.Now()) {
-				// the item's claim is expired, so return it
-				nextItem = item
-				nextItemKey = k
-				return itemFound
-			}
-			return nil
-		})
-
-		if !errors.Is(err, itemFound) {
-			// we either didn't find anything or something bad happened
-			return err
-		}
+func (t boltTxn) putFinished(q string, key string, item *FinishedItem) error {
+	fin, err := t.innerBucket(q, FinishedBucket)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("unable to marshal finished item: %w", err)
+	}
+	if err := fin.Put([]byte(key), bs); err != nil {
+		return err
+	}
 
-		newExpiryTime := time.Now().Add(claimTTL)
-		nextItem.ClaimExpiry = timestamppb.New(newExpiryTime)
-		enc, err := proto.Marshal(&nextItem)
-		if err != nil {
-			return fmt.Errorf("error marshalling claimed item: %w", err)
-		}
-		err = items.Put(nextItemKey, enc)
-		if err != nil {
-			return fmt.Errorf("error storing claimed item: %w", err)
-		}
-		return nil
-	})
+	// the index records the key under which the item is stored, so that it can be found by its id
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return err
+	}
+	index, err := ensureBucket(queueBucket, FinishedIndexBucket)
+	if err != nil {
+		return fmt.Errorf("error creating finished index bucket: %w", err)
+	}
+	return index.Put([]byte(item.GetItem().GetId()), []byte(key))
+}
 
+func (t boltTxn) getFinished(q string, id string) (*FinishedItem, error) {
+	queueBucket, err := t.queueBucket(q)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(nextItemKey) == 0 {
-		// we didn't find anything
-		return nil, nil
+	fin, err := t.innerBucket(q, FinishedBucket)
+	if err != nil {
+		return nil, err
 	}
-	return &nextItem, nil
+	index := queueBucket.Bucket([]byte(FinishedIndexBucket))
+	if index == nil {
+		return nil, ErrNotFound{}
+	}
+	key := index.Get([]byte(id))
+	if key == nil {
+		return nil, ErrNotFound{}
+	}
+	bs := fin.Get(key)
+	if bs == nil {
+		return nil, ErrNotFound{}
+	}
+	var out FinishedItem
+	if err := proto.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling finished item: %w", err)
+	}
+	return &out, nil
 }
 
-func (b *Bolt) moveItemToFinished(id string, downloadedBytes uint64, totalSizeBytes uint64, state FinishedItem_State, message string) error {
-	return b.db.Update(func(tx *bolt.Tx) error {
-		q, err := queueKeyFromItemID(id)
-		if err != nil {
-			return ErrInvalid{}
-		}
-
-		act, err := b.getQueueItemsBucket(tx, q)
-		if err != nil {
-			return err
+func (t boltTxn) walkFinished(q string, after string, descending bool, fn func(key string, item *FinishedItem) bool) error {
+	fin, err := t.innerBucket(q, FinishedBucket)
+	if err != nil {
+		return err
+	}
+	c := fin.Cursor()
+	step := c.Next
+	var k, v []byte
+	switch {
+	case after == "" && descending:
+		k, v = c.Last()
+		step = c.Prev
+	case after == "":
+		k, v = c.First()
+	case descending:
+		step = c.Prev
+		if k, v = c.Seek([]byte(after)); k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
 		}
-		fin, err := b.getFinishedItemsBucket(tx, q)
-		if err != nil {
-			return err
+	default:
+		if k, v = c.Seek([]byte(after)); k != nil && string(k) == after {
+			k, v = c.Next()
 		}
+	}
 
-		var item Item
-		itembs := act.Get([]byte(id))
-		if len(itembs) == 0 {
-			return ErrNotFound{}
+	for ; k != nil; k, v = step() {
+		item := &FinishedItem{}
+		if err := proto.Unmarshal(v, item); err != nil {
+			return fmt.Errorf("unmarshalling item: %w", err)
 		}
-		if err := proto.Unmarshal(itembs, &item); err != nil {
-			return fmt.Errorf("unable to unmarshal item: %w", err)
+		if !fn(string(k), item) {
+			break
 		}
+	}
+	return nil
+}
 
-		key, err := orderedKey()
-		if err != nil {
-			return fmt.Errorf("unable to generate key: %w", err)
-		}
+func (t boltTxn) deleteFinished(q string, key string, id string) error {
+	fin, err := t.innerBucket(q, FinishedBucket)
+	if err != nil {
+		return err
+	}
+	if err := fin.Delete([]byte(key)); err != nil {
+		return fmt.Errorf("error deleting finished item: %w", err)
+	}
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return err
+	}
+	if index := queueBucket.Bucket([]byte(FinishedIndexBucket)); index != nil {
+		return index.Delete([]byte(id))
+	}
+	return nil
+}
 
-		finished := &FinishedItem{
-			State:           state,
-			TotalSizeBytes:  totalSizeBytes,
-			DownloadedBytes: downloadedBytes,
-			Timestamp:       timestamppb.New(time.Now()),
-			Message:         message,
-			Item:            &item,
-		}
-		fbs, err := proto.Marshal(finished)
-		if err != nil {
-			return fmt.Errorf("unable to marshal finished item: %w", err)
-		}
-		if err := fin.Put([]byte(key), fbs); err != nil {
-			return fmt.Errorf("unable to write finished item: %w", err)
-		}
-		if err := act.Delete([]byte(id)); err != nil {
-			return fmt.Errorf("inable to delete item from queue: %w", err)
+func (t boltTxn) clearFinished(q string) error {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return err
+	}
+	if _, err := t.innerBucket(q, FinishedBucket); err != nil {
+		return err
+	}
+	if err := queueBucket.DeleteBucket([]byte(FinishedBucket)); err != nil {
+		return fmt.Errorf("error deleting finished items: %w", err)
+	}
+	if _, err := queueBucket.CreateBucket([]byte(FinishedBucket)); err != nil {
+		return fmt.Errorf("error creating finished bucket: %w", err)
+	}
+	if err := queueBucket.DeleteBucket([]byte(FinishedIndexBucket)); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return fmt.Errorf("error deleting finished index: %w", err)
+	}
+	return nil
+}
+
+func (t boltTxn) finishedCount(q string) (int, error) {
+	fin, err := t.innerBucket(q, FinishedBucket)
+	if err != nil {
+		return 0, err
+	}
+	return fin.Stats().KeyN, nil
+}
+
+func (t boltTxn) getKey(name string) (*ApiKey, error) {
+	keys := t.tx.Bucket([]byte(KeysBucket))
+	if keys == nil {
+		return nil, ErrNotFound{}
+	}
+	bs := keys.Get([]byte(name))
+	if bs == nil {
+		return nil, ErrNotFound{}
+	}
+	out := &ApiKey{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling key: %w", err)
+	}
+	return out, nil
+}
+
+func (t boltTxn) keys() ([]*ApiKey, error) {
+	keys := t.tx.Bucket([]byte(KeysBucket))
+	if keys == nil {
+		return nil, nil
+	}
+	var out []*ApiKey
+	err := keys.ForEach(func(k, v []byte) error {
+		key := &ApiKey{}
+		if err := proto.Unmarshal(v, key); err != nil {
+			return fmt.Errorf("error unmarshalling key %v: %w", string(k), err)
 		}
+		out = append(out, key)
 		return nil
 	})
+	return out, err
 }
 
-func orderedKey() (string, error) {
-	const size = 128
-	out := make([]byte, size)
-	n, err := rand.Read(out)
+func (t boltTxn) putKey(key *ApiKey) error {
+	keys, err := ensureBucket(t.tx, KeysBucket)
 	if err != nil {
-		return "", fmt.Errorf("error reading random data: %w", err)
+		return fmt.Errorf("error creating bucket %v: %w", KeysBucket, err)
 	}
-	if n != size {
-		return "", fmt.Errorf("did not fill buffer: %w", err)
+	bs, err := proto.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("error marshalling key: %w", err)
 	}
+	return keys.Put([]byte(key.Name), bs)
+}
 
-	now := uint64(time.Now().UnixNano())
-	binary.BigEndian.PutUint64(out, now)
+func (t boltTxn) deleteKey(name string) error {
+	keys := t.tx.Bucket([]byte(KeysBucket))
+	if keys == nil {
+		return nil
+	}
+	return keys.Delete([]byte(name))
+}
 
-	return hex.EncodeToString(out), nil
+func (t boltTxn) getCategory(name string) (*CategoryConfig, error) {
+	categories := t.tx.Bucket([]byte(CategoriesBucket))
+	if categories == nil {
+		return nil, ErrNotFound{}
+	}
+	bs := categories.Get([]byte(name))
+	if bs == nil {
+		return nil, ErrNotFound{}
+	}
+	out := &CategoryConfig{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling category: %w", err)
+	}
+	return out, nil
 }
 
-func queueKeyFromItemID(id string) (string, error) {
-	col := strings.Index(id, idSeparator)
-	if col <= 1 { // 1 because we can't have 0-length queue names
-		return "", ErrInvalid{}
+func (t boltTxn) categories() ([]*CategoryConfig, error) {
+	categories := t.tx.Bucket([]byte(CategoriesBucket))
+	if categories == nil {
+		return nil, nil
 	}
-	return id[:col], nil
+	var out []*CategoryConfig
+	err := categories.ForEach(func(k, v []byte) error {
+		category := &CategoryConfig{}
+		if err := proto.Unmarshal(v, category); err != nil {
+			return fmt.Errorf("error unmarshalling category %v: %w", string(k), err)
+		}
+		out = append(out, category)
+		return nil
+	})
+	return out, err
 }
 
-func (b *Bolt) getFinishedItemsBucket(tx *bolt.Tx, queue string) (*bolt.Bucket, error) {
-	return b.getQueueInnerBucket(tx, queue, FinishedBucket)
+func (t boltTxn) putCategory(category *CategoryConfig) error {
+	categories, err := ensureBucket(t.tx, CategoriesBucket)
+	if err != nil {
+		return fmt.Errorf("error creating bucket %v: %w", CategoriesBucket, err)
+	}
+	bs, err := proto.Marshal(category)
+	if err != nil {
+		return fmt.Errorf("error marshalling category: %w", err)
+	}
+	return categories.Put([]byte(category.Id), bs)
 }
 
-func (b *Bolt) getQueueItemsBucket(tx *bolt.Tx, queue string) (*bolt.Bucket, error) {
-	return b.getQueueInnerBucket(tx, queue, ItemsBucket)
+func (t boltTxn) deleteCategory(name string) error {
+	categories := t.tx.Bucket([]byte(CategoriesBucket))
+	if categories == nil {
+		return nil
+	}
+	return categories.Delete([]byte(name))
 }
 
-func (b *Bolt) getQueueInnerBucket(tx *bolt.Tx, queue string, itemsname string) (*bolt.Bucket, error) {
-	queuesBucket := tx.Bucket([]byte(QueueBucket))
+func (t boltTxn) queueBucket(q string) (*bolt.Bucket, error) {
+	queuesBucket := t.tx.Bucket([]byte(QueueBucket))
 	if queuesBucket == nil {
 		// it can't exist because apparently we've not even created the collection yet!
 		return nil, ErrNotFound{}
 	}
-	queueKey := sanitiseQueueName(queue)
-	queueBucket := queuesBucket.Bucket([]byte(queueKey))
+	queueBucket := queuesBucket.Bucket([]byte(q))
 	if queueBucket == nil {
 		return nil, ErrNotFound{}
 	}
-	items := queueBucket.Bucket([]byte(itemsname))
-	if items == nil {
-		return nil, ErrNotFound{}
-	}
-	return items, nil
-}
-
-var invalidQueueChars = regexp.MustCompile("[^a-zA-Z0-9_-]")
-
-func sanitiseQueueName(in string) string {
-	return invalidQueueChars.ReplaceAllLiteralString(in, "_")
+	return queueBucket, nil
 }
 
-func defaultIfEmpty[T comparable](def T, act T) T {
-	var empty T
-	if act == empty {
-		return def
+func (t boltTxn) innerBucket(q string, name string) (*bolt.Bucket, error) {
+	queueBucket, err := t.queueBucket(q)
+	if err != nil {
+		return nil, err
 	}
-	return act
-}
-
-func min[T cmp.Ordered](a, b T) T {
-	if a > b {
-		return b
+	items := queueBucket.Bucket([]byte(name))
+	if items == nil {
+		return nil, ErrNotFound{}
 	}
-	return a
+	return items, nil
 }
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"testing"
+	"time"
+)
+
+func TestStore_Leases(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			_, err := s.UpdateQueue("q", func(meta *Queue) error {
+				meta.ClaimTTL = durationpb.New(time.Millisecond)
+				meta.RetryPolicy = &RetryPolicy{MaxAttempts: 3}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unable to update queue: %v", err)
+			}
+			ids := enqueueTestItems(t, s, 0)
+
+			first, err := s.ClaimNextItem("q", "a")
+			if err != nil || first == nil {
+				t.Fatalf("expected to claim the item, got %v, %v", first, err)
+			}
+			time.Sleep(5 * time.Millisecond)
+			second, err := s.ClaimNextItem("q", "b")
+			if err != nil || second == nil || second.Id != ids[0] {
+				t.Fatalf("expected to claim the expired item again, got %v, %v", second, err)
+			}
+			if second.Lease <= first.Lease {
+				t.Fatalf("expected the new lease to supersede %v, got %v", first.Lease, second.Lease)
+			}
+
+			// the first claimant can no longer update the item
+			if _, err := s.SetItemState(ids[0], first.Lease, queue.ItemState_ITEM_STATE_DOWNLOADING, 5, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected a stale progress report to be rejected, got %v", err)
+			}
+			if _, err := s.SetItemState(ids[0], first.Lease, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected a stale completion to be rejected, got %v", err)
+			}
+			if _, err := s.SetItemState(ids[0], second.Lease, queue.ItemState_ITEM_STATE_DOWNLOADING, 5, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+				t.Errorf("unexpected error reporting progress: %v", err)
+			}
+
+			// reporting a failure ends the claim, so a repeated report is rejected
+			if _, err := s.SetItemState(ids[0], second.Lease, queue.ItemState_ITEM_STATE_FAILED, 5, 10, FailureClass_FAILURE_CLASS_NETWORK, errors.New("reset")); err != nil {
+				t.Fatalf("unexpected error failing item: %v", err)
+			}
+			_, err = s.SetItemState(ids[0], second.Lease, queue.ItemState_ITEM_STATE_FAILED, 5, 10, FailureClass_FAILURE_CLASS_NETWORK, errors.New("reset"))
+			if !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected a repeated failure to be rejected, got %v", err)
+			}
+		})
+	}
+}
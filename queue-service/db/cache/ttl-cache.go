@@ -7,7 +7,9 @@ import (
 )
 
 type TTL[K comparable, V any] struct {
-	TTL                 time.Duration
+	TTL time.Duration
+	// Now returns the current time, which values expire against.  If nil, time.Now is used.
+	Now                 func() time.Time
 	data                map[K]ttlItem[V]
 	mux                 sync.RWMutex
 	cleanLoopRunningMux sync.Mutex
@@ -19,7 +21,7 @@ func (t *TTL[K, V]) Get(k K) (out V, ok bool) {
 	var tmp ttlItem[V]
 	tmp, ok = t.data[k]
 	if ok {
-		if !tmp.isExpired() {
+		if !tmp.isExpired(t.now()) {
 			out = tmp.value
 		} else {
 			// expired
@@ -38,17 +40,32 @@ func (t *TTL[K, V]) Set(k K, v V) {
 	}
 
 	t.data[k] = ttlItem[V]{
-		exp:   time.Now().Add(t.TTL),
+		exp:   t.now().Add(t.TTL),
 		value: v,
 	}
 }
 
+// Values returns the values which haven't expired, in no particular order.
+func (t *TTL[K, V]) Values() []V {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	out := make([]V, 0, len(t.data))
+	now := t.now()
+	for _, v := range t.data {
+		if !v.isExpired(now) {
+			out = append(out, v.value)
+		}
+	}
+	return out
+}
+
 func (t *TTL[K, V]) Clean() {
 	t.mux.Lock()
 	defer t.mux.Unlock()
 
+	now := t.now()
 	for k, v := range t.data {
-		if v.isExpired() {
+		if v.isExpired(now) {
 			delete(t.data, k)
 		}
 	}
@@ -75,13 +92,20 @@ func (t *TTL[K, V]) CleanLoopAsync(ctx context.Context, period time.Duration) {
 	}()
 }
 
+func (t *TTL[K, V]) now() time.Time {
+	if t.Now == nil {
+		return time.Now()
+	}
+	return t.Now()
+}
+
 type ttlItem[V any] struct {
 	exp   time.Time
 	value V
 }
 
-func (t *ttlItem[V]) isExpired() bool {
-	if t.exp.After(time.Now()) {
+func (t *ttlItem[V]) isExpired(now time.Time) bool {
+	if t.exp.After(now) {
 		return false
 	}
 	return true
@@ -0,0 +1,120 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ListCategories returns the settings of the store's categories, ordered by name.
+func (s *store) ListCategories() ([]*CategoryConfig, error) {
+	var out []*CategoryConfig
+	err := s.backend.view(func(tx txn) error {
+		var err error
+		out, err = tx.categories()
+		return err
+	})
+	return out, err
+}
+
+// GetCategory returns the settings of the named category.
+func (s *store) GetCategory(name string) (*CategoryConfig, error) {
+	var out *CategoryConfig
+	err := s.backend.view(func(tx txn) error {
+		var err error
+		out, err = tx.getCategory(name)
+		return err
+	})
+	return out, err
+}
+
+// CreateCategory stores the settings of a new category, named by its id.  It returns ErrConflict
+// if the category exists.
+func (s *store) CreateCategory(category *CategoryConfig) error {
+	category.Timestamp = &timestamp.Timestamp{
+		Seconds: time.Now().Unix(),
+	}
+	return s.backend.update(func(tx txn) error {
+		if _, err := tx.getCategory(category.Id); err == nil {
+			return ErrConflict{}
+		}
+		return tx.putCategory(category)
+	})
+}
+
+// UpdateCategory changes the named category by applying update to its settings, and returns the
+// updated settings.  If update returns an error, the category is left unchanged.
+func (s *store) UpdateCategory(name string, update func(category *CategoryConfig) error) (*CategoryConfig, error) {
+	var out *CategoryConfig
+	err := s.backend.update(func(tx txn) error {
+		category, err := tx.getCategory(name)
+		if err != nil {
+			return err
+		}
+		if err := update(category); err != nil {
+			return err
+		}
+		// the category is stored under its name, which can't be changed
+		category.Id = name
+		out = category
+		return tx.putCategory(category)
+	})
+	return out, err
+}
+
+// DeleteCategory removes the named category's settings.  Items given the category keep it.
+func (s *store) DeleteCategory(name string) error {
+	return s.backend.update(func(tx txn) error {
+		if _, err := tx.getCategory(name); err != nil {
+			return err
+		}
+		return tx.deleteCategory(name)
+	})
+}
+
+// AllowsHost reports whether the category allows items to be downloaded from host.  Categories
+// without a host list allow any host.  Entries beginning "*." allow the domain and its subdomains.
+func (c *CategoryConfig) AllowsHost(host string) bool {
+	if len(c.GetAllowedHosts()) == 0 {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, entry := range c.AllowedHosts {
+		if host == entry {
+			return true
+		}
+		if parent, ok := strings.CutPrefix(entry, "*."); ok && (host == parent || strings.HasSuffix(host, "."+parent)) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAllowedHosts returns ErrInvalid if the item's category doesn't allow downloads from the
+// host of its source, or of the source set for its next attempt.  Items whose category has no
+// settings may be downloaded from any host.
+func checkAllowedHosts(tx txn, item *Item) error {
+	category, err := tx.getCategory(item.GetCategory().GetId())
+	if errors.As(err, &ErrNotFound{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, src := range []*Target{item.Source, item.NextSource} {
+		if src == nil {
+			continue
+		}
+		u, err := url.Parse(src.Url)
+		if err != nil {
+			return fmt.Errorf("source url is invalid: %v: %w", err, ErrInvalid{})
+		}
+		if !category.AllowsHost(u.Hostname()) {
+			return fmt.Errorf("category %v doesn't allow downloads from %v: %w", category.Id, u.Hostname(), ErrInvalid{})
+		}
+	}
+	return nil
+}
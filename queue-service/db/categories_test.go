@@ -0,0 +1,134 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStore_Categories(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+
+			for _, name := range []string{"video", "iso"} {
+				if err := s.CreateCategory(&CategoryConfig{Id: name, DestinationTemplate: name}); err != nil {
+					t.Fatalf("unable to create category: %v", err)
+				}
+			}
+			if err := s.CreateCategory(&CategoryConfig{Id: "iso"}); !errors.As(err, &ErrConflict{}) {
+				t.Errorf("expected creating an existing category to fail, got %v", err)
+			}
+			categories, err := s.ListCategories()
+			if err != nil || len(categories) != 2 || categories[0].Id != "iso" || categories[1].Id != "video" {
+				t.Errorf("expected the categories in name order, got %v, %v", categories, err)
+			}
+
+			updated, err := s.UpdateCategory("iso", func(category *CategoryConfig) error {
+				category.AllowedHosts = []string{"example.com"}
+				return nil
+			})
+			if err != nil || updated.DestinationTemplate != "iso" || !slices.Equal(updated.AllowedHosts, []string{"example.com"}) {
+				t.Errorf("expected the category's hosts to be changed, got %v, %v", updated, err)
+			}
+			if got, err := s.GetCategory("iso"); err != nil || !slices.Equal(got.AllowedHosts, []string{"example.com"}) {
+				t.Errorf("expected the change to be stored, got %v, %v", got, err)
+			}
+			if _, err := s.UpdateCategory("missing", func(*CategoryConfig) error { return nil }); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected updating a missing category to fail, got %v", err)
+			}
+
+			if err := s.DeleteCategory("video"); err != nil {
+				t.Fatalf("unable to delete category: %v", err)
+			}
+			if _, err := s.GetCategory("video"); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected the category to be deleted, got %v", err)
+			}
+			if err := s.DeleteCategory("video"); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected deleting a missing category to fail, got %v", err)
+			}
+		})
+	}
+}
+
+func TestStore_CategoryRetryPolicy(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			err := s.CreateCategory(&CategoryConfig{
+				Id:          "default",
+				RetryPolicy: &RetryPolicy{MaxAttempts: 2, InitialBackoff: durationpb.New(time.Hour)},
+			})
+			if err != nil {
+				t.Fatalf("unable to create category: %v", err)
+			}
+			ids := enqueueTestItems(t, s, 0, 0)
+
+			// the category's policy applies in place of the queue's, which doesn't retry
+			claimed, err := s.ClaimNextItem("q", "")
+			if err != nil || claimed.Id != ids[0] {
+				t.Fatalf("expected to claim %v, got %v, %v", ids[0], claimed, err)
+			}
+			ch, err := s.SetItemState(ids[0], claimed.Lease, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NETWORK, errors.New("connection reset"))
+			if err != nil || len(ch.Updated) != 1 || ch.Updated[0].Id != ids[0] {
+				t.Fatalf("expected the item to be rescheduled, got %v, %v", ch, err)
+			}
+
+			// without the category, the queue's policy applies again
+			if err := s.DeleteCategory("default"); err != nil {
+				t.Fatalf("unable to delete category: %v", err)
+			}
+			claimed, err = s.ClaimNextItem("q", "")
+			if err != nil || claimed.Id != ids[1] {
+				t.Fatalf("expected to claim %v, got %v, %v", ids[1], claimed, err)
+			}
+			ch, err = s.SetItemState(ids[1], claimed.Lease, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NETWORK, errors.New("connection reset"))
+			if err != nil || len(ch.Updated) != 0 {
+				t.Fatalf("expected the item to finish, got %v, %v", ch, err)
+			}
+		})
+	}
+}
+
+func TestStore_CategoryAllowedHosts(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := finishTestItems(t, s, queue.ItemState_ITEM_STATE_COMPLETE)
+			if err := s.CreateCategory(&CategoryConfig{Id: "default", AllowedHosts: []string{"*.example.com"}}); err != nil {
+				t.Fatalf("unable to create category: %v", err)
+			}
+
+			_, err := s.EnqueueItem("q", &Item{
+				Source:      &Target{Url: "http://example.org/a.iso"},
+				Destination: &Target{Url: "file://a.iso"},
+			})
+			if !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected an item from a host the category doesn't allow to be rejected, got %v", err)
+			}
+			id := enqueueTestItems(t, s, 0)[0]
+			if _, err := s.UpdateItem(id, ItemUpdate{Source: &Target{Url: "http://example.org/a.iso"}}); !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected changing the source to a host the category doesn't allow to fail, got %v", err)
+			}
+			if _, err := s.UpdateItem(id, ItemUpdate{Source: &Target{Url: "http://example.org/a.iso"}, Category: &Category{Id: "other"}}); err != nil {
+				t.Fatalf("expected a category without settings to allow any host, got %v", err)
+			}
+			if _, err := s.UpdateItem(id, ItemUpdate{Category: &Category{Id: "default"}}); !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected changing to a category which doesn't allow the source to fail, got %v", err)
+			}
+
+			if _, err := s.UpdateCategory("default", func(category *CategoryConfig) error {
+				category.AllowedHosts = []string{"example.net"}
+				return nil
+			}); err != nil {
+				t.Fatalf("unable to update category: %v", err)
+			}
+			if _, err := s.RequeueItems("q", ids, nil); !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected requeueing an item from a host the category no longer allows to fail, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultClaimTTL = time.Second * 30
+	// MinClaimTTL is the shortest claim TTL a queue can have, which leaves time for downloaders'
+	// progress reports to renew their claims.
+	MinClaimTTL = time.Second * 10
+	// DefaultCategory is the category of items added without one to a queue which has no default
+	// category of its own.
+	DefaultCategory = "default"
+)
+
+// ClaimTTLOrDefault returns how long a claim on one of the queue's items lasts without a
+// progress report.
+func (q *Queue) ClaimTTLOrDefault() time.Duration {
+	return defaultIfEmpty(DefaultClaimTTL, q.GetClaimTTL().AsDuration())
+}
+
+// GetQueue returns the queue's record, including its settings.
+func (s *store) GetQueue(queue string) (*Queue, error) {
+	var out *Queue
+	err := s.backend.view(func(tx txn) error {
+		var err error
+		out, err = tx.getQueueMeta(sanitiseQueueName(queue))
+		return err
+	})
+	return out, err
+}
+
+// UpdateQueue changes the queue's settings by applying update to its record, and returns the
+// updated record.  If update returns an error, the record is left unchanged.
+func (s *store) UpdateQueue(queue string, update func(meta *Queue) error) (*Queue, error) {
+	var out *Queue
+	err := s.backend.update(func(tx txn) error {
+		meta, err := tx.getQueueMeta(sanitiseQueueName(queue))
+		if err != nil {
+			return err
+		}
+		if err := update(meta); err != nil {
+			return err
+		}
+		out = meta
+		return tx.putQueueMeta(meta)
+	})
+	return out, err
+}
+
+// applyDefaults fills in the parts of a new item which are left to the queue's settings.
+func (q *Queue) applyDefaults(item *Item) {
+	if item.GetCategory().GetId() == "" {
+		item.Category = &Category{Id: defaultIfEmpty(DefaultCategory, q.DefaultCategory)}
+	}
+	if q.DefaultDestinationPrefix != "" && item.Destination != nil {
+		item.Destination.Url = prefixDestination(q.DefaultDestinationPrefix, item.Destination.Url)
+	}
+}
+
+// prefixDestination places a relative file destination, such as file://name.iso, under the
+// given prefix.  Other destinations are returned unchanged.
+func prefixDestination(prefix string, destination string) string {
+	const fileScheme = "file://"
+	if !strings.HasPrefix(destination, fileScheme) {
+		return destination
+	}
+	rest := strings.TrimPrefix(destination, fileScheme)
+	if strings.HasPrefix(rest, "/") {
+		return destination
+	}
+	return fileScheme + strings.TrimPrefix(path.Join(prefix, rest), "/")
+}
+
+// claimedCount returns the number of items with a live claim.
+func claimedCount(items []*Item, now time.Time) int {
+	count := 0
+	for _, item := range items {
+		if item.ClaimExpiry.AsTime().After(now) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"github.com/harryrose/godm/queue-service/queue"
+	"testing"
+)
+
+func TestPrefixDestination(t *testing.T) {
+	tests := []struct {
+		destination string
+		want        string
+	}{
+		{"file://name.iso", "file://downloads/name.iso"},
+		{"file://isos/name.iso", "file://downloads/isos/name.iso"},
+		{"file:///tmp/name.iso", "file:///tmp/name.iso"},
+		{"s3://bucket/name.iso", "s3://bucket/name.iso"},
+	}
+	for _, tt := range tests {
+		if got := prefixDestination("downloads", tt.destination); got != tt.want {
+			t.Errorf("%q: expected %q, got %q", tt.destination, tt.want, got)
+		}
+	}
+}
+
+func TestStore_QueueSettings(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			_, err := s.UpdateQueue("q", func(meta *Queue) error {
+				meta.MaxConcurrentClaims = 1
+				meta.DefaultCategory = "isos"
+				meta.DefaultDestinationPrefix = "downloads"
+				meta.DefaultRateLimit = 1000
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unable to update queue: %v", err)
+			}
+			if err := s.CreateCategory(&CategoryConfig{Id: "isos", RateLimit: 500}); err != nil {
+				t.Fatalf("unable to create category: %v", err)
+			}
+
+			id, err := s.EnqueueItem("q", &Item{
+				Source:      &Target{Url: "http://example.com/name.iso"},
+				Destination: &Target{Url: "file://name.iso"},
+			})
+			if err != nil {
+				t.Fatalf("unable to enqueue item: %v", err)
+			}
+			enqueueTestItems(t, s, 0)
+
+			claimed, err := s.ClaimNextItem("q", "")
+			if err != nil || claimed == nil || claimed.Id != id {
+				t.Fatalf("expected to claim %v, got %v, %v", id, claimed, err)
+			}
+			if claimed.Category.GetId() != "isos" || claimed.Destination.Url != "file://downloads/name.iso" {
+				t.Errorf("expected the queue's defaults to apply, got %v", claimed)
+			}
+			if claimed.ClaimTTL != DefaultClaimTTL || claimed.RateLimit != 500 || claimed.CategorySettings.GetId() != "isos" {
+				t.Errorf("expected the claim to carry the queue's and category's settings, got %+v", claimed)
+			}
+			if claimed, err := s.ClaimNextItem("q", ""); err != nil || claimed != nil {
+				t.Fatalf("expected the concurrency cap to prevent a claim, got %v, %v", claimed, err)
+			}
+
+			if _, err := s.SetItemState(id, 0, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+				t.Fatalf("unexpected error completing item: %v", err)
+			}
+			claimed, err = s.ClaimNextItem("q", "")
+			if err != nil || claimed == nil {
+				t.Fatalf("expected to claim once the first item finished, got %v, %v", claimed, err)
+			}
+			if claimed.RateLimit != 1000 || claimed.CategorySettings != nil {
+				t.Errorf("expected an item without category settings to have the queue's rate limit, got %+v", claimed)
+			}
+		})
+	}
+}
+
+func TestStore_NoDefaultCategory(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			id, err := s.EnqueueItem("q", &Item{
+				Source:      &Target{Url: "http://example.com/name.iso"},
+				Destination: &Target{Url: "file://name.iso"},
+			})
+			if err != nil {
+				t.Fatalf("unable to enqueue item: %v", err)
+			}
+			items, _, err := s.GetQueueItems("q", ItemQuery{})
+			if err != nil || len(items) != 1 || items[0].Category.GetId() != DefaultCategory {
+				t.Errorf("expected an item without a category to be in %q, got %v, %v", DefaultCategory, items, err)
+			}
+			item, err := s.UpdateItem(id, ItemUpdate{Category: &Category{}})
+			if err != nil || item.Category.GetId() != DefaultCategory {
+				t.Errorf("expected clearing the category to put the item in %q, got %v, %v", DefaultCategory, item, err)
+			}
+		})
+	}
+}
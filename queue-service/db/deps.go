@@ -0,0 +1,128 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Changes records the items affected by an operation, other than the item it was asked to change.
+type Changes struct {
+	// Enqueued holds items added to a queue, such as the next run of a recurring item.
+	Enqueued []*Item
+	// Updated holds active items whose state changed, such as failed items awaiting a retry, or
+	// items whose dependencies finished.
+	Updated []*Item
+	// Finished holds items moved to a queue's history, such as items whose dependencies failed.
+	Finished []*FinishedItem
+}
+
+func (c *Changes) merge(other *Changes) {
+	c.Enqueued = append(c.Enqueued, other.Enqueued...)
+	c.Updated = append(c.Updated, other.Updated...)
+	c.Finished = append(c.Finished, other.Finished...)
+}
+
+// Blocked reports whether the item is waiting for the items it depends on.
+func (i *Item) Blocked() bool {
+	return len(i.PendingDependencies) > 0 || i.HeldBy != ""
+}
+
+// BlockedReason describes why a blocked item can't be claimed.
+func (i *Item) BlockedReason() string {
+	switch {
+	case i.HeldBy != "":
+		return fmt.Sprintf("held because prerequisite %s did not complete", i.HeldBy)
+	case len(i.PendingDependencies) > 0:
+		return fmt.Sprintf("waiting for %s to complete", strings.Join(i.PendingDependencies, ", "))
+	default:
+		return ""
+	}
+}
+
+// resolveDependencies records which of a new item's dependencies are yet to complete.  Every
+// dependency must be an item in queue q, either active or in its history.
+func resolveDependencies(tx txn, q string, item *Item) error {
+	item.PendingDependencies = nil
+	for _, dep := range item.DependsOn {
+		depQueue, err := queueKeyFromItemID(dep)
+		if err != nil || depQueue != q {
+			return fmt.Errorf("prerequisite %v must be in the same queue: %w", dep, ErrInvalid{})
+		}
+		_, err = tx.getItem(q, dep)
+		if err == nil {
+			item.PendingDependencies = append(item.PendingDependencies, dep)
+			continue
+		}
+		if !errors.Is(err, ErrNotFound{}) {
+			return fmt.Errorf("prerequisite %v: %w", dep, err)
+		}
+
+		finished, err := tx.getFinished(q, dep)
+		if err != nil {
+			return fmt.Errorf("prerequisite %v: %w", dep, err)
+		}
+		if finished.State == FinishedItem_ITEM_STATE_SUCCESS {
+			continue
+		}
+		if item.DependencyPolicy != DependencyPolicy_DEPENDENCY_POLICY_HOLD {
+			return fmt.Errorf("prerequisite %v has already failed: %w", dep, ErrPrecondition{})
+		}
+		if item.HeldBy == "" {
+			item.HeldBy = dep
+		}
+	}
+	return nil
+}
+
+// resolveDependents updates the items in queue q which depend on an item that has just finished.
+// If it completed, they no longer wait for it.  Otherwise, depending on their policy, they are
+// either held or failed, which may in turn affect the items which depend on them.
+func resolveDependents(tx txn, q string, id string, state FinishedItem_State, ch *Changes) error {
+	items, err := orderedItems(tx, q)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if !slices.Contains(item.PendingDependencies, id) {
+			continue
+		}
+		// failing an earlier dependent may already have finished or changed this one
+		item, err = tx.getItem(q, item.Id)
+		if errors.Is(err, ErrNotFound{}) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		item.PendingDependencies = slices.DeleteFunc(item.PendingDependencies, func(dep string) bool {
+			return dep == id
+		})
+
+		if state != FinishedItem_ITEM_STATE_SUCCESS {
+			if item.DependencyPolicy != DependencyPolicy_DEPENDENCY_POLICY_HOLD {
+				// collect the changes this causes separately, so that they are reported after it
+				cascade := &Changes{}
+				message := fmt.Sprintf("prerequisite %s did not complete", id)
+				finished, err := finishItem(tx, q, item, 0, item.TotalSizeBytes, FinishedItem_ITEM_STATE_FAILED, message, cascade)
+				if err != nil {
+					return err
+				}
+				ch.Finished = append(ch.Finished, finished)
+				ch.merge(cascade)
+				continue
+			}
+			if item.HeldBy == "" {
+				item.HeldBy = id
+			}
+		}
+
+		if err := tx.putItem(q, item); err != nil {
+			return err
+		}
+		ch.Updated = append(ch.Updated, item)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"slices"
+	"testing"
+	"time"
+)
+
+func enqueueDependent(t *testing.T, b Store, policy DependencyPolicy, dependsOn ...string) string {
+	t.Helper()
+	id, err := b.EnqueueItem("q", &Item{
+		Source:           &Target{Url: "http://example.com/part"},
+		Destination:      &Target{Url: "file://part"},
+		Category:         &Category{Id: "default"},
+		DependsOn:        dependsOn,
+		DependencyPolicy: policy,
+	})
+	if err != nil {
+		t.Fatalf("unable to enqueue dependent item: %v", err)
+	}
+	return id
+}
+
+func activeItem(t *testing.T, b Store, id string) *Item {
+	t.Helper()
+	items, _, err := b.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+	if err != nil {
+		t.Fatalf("unable to get queue items: %v", err)
+	}
+	idx := slices.IndexFunc(items, func(item *Item) bool { return item.Id == id })
+	if idx < 0 {
+		return nil
+	}
+	return items[idx]
+}
+
+func TestStore_Dependencies(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 0)
+			dependent := enqueueDependent(t, s, DependencyPolicy_DEPENDENCY_POLICY_FAIL, ids...)
+
+			item := activeItem(t, s, dependent)
+			if state := item.State(time.Now()); state != queue.ItemState_ITEM_STATE_BLOCKED {
+				t.Errorf("expected the dependent to be blocked, got %v", state)
+			}
+			for i := 0; i < 2; i++ {
+				claimed, err := s.ClaimNextItem("q", "")
+				if err != nil || claimed == nil || claimed.Id == dependent {
+					t.Fatalf("expected to claim a prerequisite, got %v, %v", claimed, err)
+				}
+			}
+			if claimed, err := s.ClaimNextItem("q", ""); err != nil || claimed != nil {
+				t.Fatalf("expected the dependent not to be claimable, got %v, %v", claimed, err)
+			}
+
+			ch, err := s.SetItemState(ids[0], 0, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil)
+			if err != nil {
+				t.Fatalf("unexpected error completing item: %v", err)
+			}
+			if len(ch.Updated) != 1 || !slices.Equal(ch.Updated[0].PendingDependencies, ids[1:]) {
+				t.Fatalf("expected the dependent to wait only for %v, got %v", ids[1:], ch.Updated)
+			}
+			if _, err := s.SetItemState(ids[1], 0, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+				t.Fatalf("unexpected error completing item: %v", err)
+			}
+			claimed, err := s.ClaimNextItem("q", "")
+			if err != nil || claimed == nil || claimed.Id != dependent {
+				t.Fatalf("expected to claim the dependent, got %v, %v", claimed, err)
+			}
+
+			// completed items can be depended on, but failed ones can't
+			enqueueDependent(t, s, DependencyPolicy_DEPENDENCY_POLICY_FAIL, ids[0])
+			if _, err := s.CancelItem(dependent); err != nil {
+				t.Fatalf("unexpected error cancelling item: %v", err)
+			}
+			_, err = s.EnqueueItem("q", &Item{DependsOn: []string{dependent}})
+			if !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected depending on a failed item to be rejected, got %v", err)
+			}
+			_, err = s.EnqueueItem("q", &Item{DependsOn: []string{"q:00000000000000000099"}})
+			if !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected depending on an unknown item to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestStore_DependencyFailure(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0)
+			failed := enqueueDependent(t, s, DependencyPolicy_DEPENDENCY_POLICY_FAIL, ids[0])
+			held := enqueueDependent(t, s, DependencyPolicy_DEPENDENCY_POLICY_HOLD, ids[0])
+			// depends on an item which will fail because of its own dependency
+			cascaded := enqueueDependent(t, s, DependencyPolicy_DEPENDENCY_POLICY_FAIL, failed)
+
+			ch, err := s.SetItemState(ids[0], 0, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NOT_FOUND, errors.New("not found"))
+			if err != nil {
+				t.Fatalf("unexpected error failing item: %v", err)
+			}
+
+			var finished []string
+			for _, f := range ch.Finished {
+				finished = append(finished, f.Item.Id)
+			}
+			if want := []string{failed, cascaded}; !slices.Equal(finished, want) {
+				t.Errorf("expected %v to fail, got %v", want, finished)
+			}
+			if got := queueOrder(t, s); !slices.Equal(got, []string{held}) {
+				t.Errorf("expected only the held item to remain, got %v", got)
+			}
+			item := activeItem(t, s, held)
+			if state := item.State(time.Now()); state != queue.ItemState_ITEM_STATE_BLOCKED || item.HeldBy != ids[0] {
+				t.Errorf("expected the item to be held by %v, got %v", ids[0], item)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"net/url"
+	"path"
+	"strings"
+)
+
+// maxDuplicateHistory is the number of the newest items in a queue's history which duplicate
+// checks look through, so that checks don't take longer as the history grows.
+const maxDuplicateHistory = 1000
+
+// DuplicateCheck determines which existing items an item being enqueued duplicates.  Urls are
+// compared once they have been normalised by normaliseURL.  An item's source matches if either
+// its source or the source set for its next attempt does.
+type DuplicateCheck struct {
+	// Source and Destination are the fields which must match.  If neither is set, sources are
+	// compared.
+	Source      bool
+	Destination bool
+	// History extends the check to the items which completed among the newest
+	// maxDuplicateHistory items in the queue's history.
+	History bool
+}
+
+// findDuplicate returns the id of an item in queue q which item duplicates, or an empty string
+// if there is none.  Items in the queue are preferred, oldest first, over those in the history.
+func findDuplicate(tx txn, q string, item *Item, check DuplicateCheck) (string, error) {
+	setTargetKeys(item)
+	matches := check.matcher(item)
+	items, err := tx.items(q)
+	if err != nil {
+		return "", err
+	}
+	var found string
+	for _, existing := range items {
+		// ids are zero padded, so order as the items were enqueued
+		if matches(existing) && (found == "" || existing.Id < found) {
+			found = existing.Id
+		}
+	}
+	if found != "" || !check.History {
+		return found, nil
+	}
+	walked := 0
+	err = tx.walkFinished(q, "", true, func(_ string, finished *FinishedItem) bool {
+		if finished.State == FinishedItem_ITEM_STATE_SUCCESS && matches(finished.Item) {
+			found = finished.Item.Id
+			return false
+		}
+		walked++
+		return walked < maxDuplicateHistory
+	})
+	return found, err
+}
+
+// matcher returns a function which reports whether an existing item is duplicated by item.
+func (c DuplicateCheck) matcher(item *Item) func(existing *Item) bool {
+	if !c.Source && !c.Destination {
+		c.Source = true
+	}
+	source := targetKey(item.Source)
+	destination := targetKey(item.Destination)
+	return func(existing *Item) bool {
+		sourceMatches := targetKey(existing.Source) == source ||
+			(existing.NextSource != nil && targetKey(existing.NextSource) == source)
+		return (!c.Source || sourceMatches) &&
+			(!c.Destination || targetKey(existing.Destination) == destination)
+	}
+}
+
+// setTargetKeys records the normalised urls of the item's targets, so that duplicate checks
+// needn't normalise them again.
+func setTargetKeys(item *Item) {
+	for _, target := range []*Target{item.Source, item.Destination, item.NextSource} {
+		if target != nil {
+			target.Key = normaliseURL(target.Url)
+		}
+	}
+}
+
+// targetKey returns the normalised url of the target.  Targets stored before keys were recorded
+// are normalised as they are compared.
+func targetKey(target *Target) string {
+	if target.GetKey() != "" {
+		return target.Key
+	}
+	return normaliseURL(target.GetUrl())
+}
+
+// normaliseURL returns a form of the url which is the same for urls that refer to the same
+// file: the scheme and host are lower cased, default ports and fragments are dropped, and query
+// parameters are sorted.  The paths of file urls are cleaned, but keep their case.  Urls which
+// can't be parsed are returned unchanged.
+func normaliseURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Fragment, u.RawFragment = "", ""
+	if u.Scheme == "file" {
+		// relative destinations, such as file://name.iso, are parsed with the name as the host
+		return "file://" + path.Clean(u.Host+u.Path)
+	}
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = strings.TrimSuffix(u.Host, ":"+port)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	u.RawQuery = u.Query().Encode()
+	return u.String()
+}
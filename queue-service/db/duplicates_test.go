@@ -0,0 +1,139 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/queue"
+	"testing"
+)
+
+func TestNormaliseURL(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{"https://Example.COM/a.iso", "https://example.com/a.iso", true},
+		{"https://example.com:443/a.iso", "https://example.com/a.iso", true},
+		{"http://example.com:80/a.iso", "http://example.com/a.iso", true},
+		{"https://example.com:8443/a.iso", "https://example.com/a.iso", false},
+		{"https://example.com/a.iso?b=2&a=1", "https://example.com/a.iso?a=1&b=2", true},
+		{"https://example.com/a.iso#top", "https://example.com/a.iso", true},
+		{"https://example.com", "https://example.com/", true},
+		{"https://example.com/A.iso", "https://example.com/a.iso", false},
+		{"file://isos/./a.iso", "file://isos/a.iso", true},
+		{"file://A.iso", "file://a.iso", false},
+		{"file:///srv/a.iso", "file://srv/a.iso", false},
+	}
+	for _, tt := range tests {
+		if got := normaliseURL(tt.a) == normaliseURL(tt.b); got != tt.same {
+			t.Errorf("%v and %v: expected same to be %v, got %v and %v", tt.a, tt.b, tt.same, normaliseURL(tt.a), normaliseURL(tt.b))
+		}
+	}
+}
+
+func TestStore_EnqueueUniqueItem(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, &Queue{DefaultDestinationPrefix: "isos"})
+			newItem := func(src, dst string) *Item {
+				return &Item{Source: &Target{Url: src}, Destination: &Target{Url: dst}}
+			}
+			first, err := s.EnqueueItem("q", newItem("https://example.com/a.iso", "file://a.iso"))
+			if err != nil {
+				t.Fatalf("unable to enqueue item: %v", err)
+			}
+
+			var dup ErrDuplicate
+			_, err = s.EnqueueUniqueItem("q", newItem("https://EXAMPLE.com:443/a.iso", "file://b.iso"), DuplicateCheck{})
+			if !errors.As(err, &dup) || dup.ID != first {
+				t.Errorf("expected the same source to duplicate %v, got %v", first, err)
+			}
+			// destinations are compared with the queue's prefix applied
+			_, err = s.EnqueueUniqueItem("q", newItem("https://mirror.org/a.iso", "file://a.iso"), DuplicateCheck{Destination: true})
+			if !errors.As(err, &dup) || dup.ID != first {
+				t.Errorf("expected the same destination to duplicate %v, got %v", first, err)
+			}
+			second, err := s.EnqueueUniqueItem("q", newItem("https://example.com/a.iso", "file://b.iso"), DuplicateCheck{Source: true, Destination: true})
+			if err != nil {
+				t.Errorf("expected an item with another destination to be enqueued, got %v", err)
+			}
+
+			// completed items are only matched if the history is checked
+			for _, id := range []string{first, second} {
+				claimed, err := s.ClaimNextItem("q", "w")
+				if err != nil || claimed.Id != id {
+					t.Fatalf("unable to claim item: %v, %v", claimed, err)
+				}
+				state, class, cause := queue.ItemState_ITEM_STATE_COMPLETE, FailureClass_FAILURE_CLASS_UNSPECIFIED, error(nil)
+				if id == second {
+					state, class, cause = queue.ItemState_ITEM_STATE_FAILED, FailureClass_FAILURE_CLASS_NOT_FOUND, errors.New("not found")
+				}
+				if _, err := s.SetItemState(id, claimed.Lease, state, 0, 0, class, cause); err != nil {
+					t.Fatalf("unable to finish item: %v", err)
+				}
+			}
+			_, err = s.EnqueueUniqueItem("q", newItem("https://example.com/a.iso", "file://a.iso"), DuplicateCheck{Source: true, Destination: true, History: true})
+			if !errors.As(err, &dup) || dup.ID != first {
+				t.Errorf("expected the completed item to be duplicated, got %v", err)
+			}
+			if _, err := s.EnqueueUniqueItem("q", newItem("https://example.com/a.iso", "file://b.iso"), DuplicateCheck{Source: true, Destination: true, History: true}); err != nil {
+				t.Errorf("expected the failed item not to be duplicated, got %v", err)
+			}
+			if _, err := s.EnqueueUniqueItem("q", newItem("https://example.com/a.iso", "file://a.iso"), DuplicateCheck{}); err == nil {
+				t.Errorf("expected the queued item to be duplicated")
+			}
+		})
+	}
+}
+
+func TestStore_DuplicateNextSource(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			id := enqueueTestItems(t, s, 0)[0]
+			if _, err := s.ClaimNextItem("q", "w"); err != nil {
+				t.Fatalf("unable to claim item: %v", err)
+			}
+			if _, err := s.UpdateItem(id, ItemUpdate{Source: &Target{Url: "http://mirror.example.com/a.iso"}, NextAttempt: true}); err != nil {
+				t.Fatalf("unable to change the item's source: %v", err)
+			}
+			var dup ErrDuplicate
+			_, err := s.EnqueueUniqueItem("q", &Item{
+				Source:      &Target{Url: "http://MIRROR.example.com/a.iso"},
+				Destination: &Target{Url: "file://a.iso"},
+			}, DuplicateCheck{})
+			if !errors.As(err, &dup) || dup.ID != id {
+				t.Errorf("expected the source for the item's next attempt to be duplicated, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMemory_DuplicateHistoryLimit(t *testing.T) {
+	m := NewMemory()
+	if _, err := m.CreateQueue("q", nil); err != nil {
+		t.Fatalf("unable to create queue: %v", err)
+	}
+	old := finishTestItems(t, m, queue.ItemState_ITEM_STATE_COMPLETE)[0]
+	// fill the history with newer items than the completed one
+	err := m.backend.update(func(tx txn) error {
+		for i := range maxDuplicateHistory {
+			key, err := orderedKey()
+			if err != nil {
+				return err
+			}
+			item := &Item{Id: fmt.Sprintf("q:%020d", 1000+i), Source: &Target{Url: "http://example.com/other"}}
+			if err := tx.putFinished("q", key, &FinishedItem{State: FinishedItem_ITEM_STATE_SUCCESS, Item: item}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to fill the history: %v", err)
+	}
+	item := &Item{Source: &Target{Url: "http://example.com"}, Destination: &Target{Url: "file://example"}}
+	if _, err := m.EnqueueUniqueItem("q", item, DuplicateCheck{History: true}); err != nil {
+		t.Errorf("expected %v to be too old to be duplicated, got %v", old, err)
+	}
+}
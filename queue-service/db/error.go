@@ -3,7 +3,15 @@ package db
 type ErrConflict struct{}
 type ErrNotFound struct{}
 type ErrInvalid struct{}
+type ErrPrecondition struct{}
 
-func (e ErrConflict) Error() string { return "conflict" }
-func (e ErrNotFound) Error() string { return "not found" }
-func (e ErrInvalid) Error() string  { return "invalid input" }
+// ErrDuplicate is returned when an item isn't enqueued because it duplicates the item with ID.
+type ErrDuplicate struct {
+	ID string
+}
+
+func (e ErrConflict) Error() string     { return "conflict" }
+func (e ErrNotFound) Error() string     { return "not found" }
+func (e ErrInvalid) Error() string      { return "invalid input" }
+func (e ErrPrecondition) Error() string { return "precondition failed" }
+func (e ErrDuplicate) Error() string    { return "duplicate of item " + e.ID }
@@ -0,0 +1,279 @@
+package db
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"os"
+)
+
+// Record types in an export.  An export begins with a header, and each queue's record precedes
+// those of its items.
+const (
+	exportHeader   = "header"
+	exportQueue    = "queue"
+	exportItem     = "item"
+	exportFinished = "finished"
+	exportKey      = "key"
+	exportCategory = "category"
+)
+
+// maxExportLine is the longest line Import accepts.
+const maxExportLine = 16 << 20
+
+// exportRecord is a line of an export.  Record holds the queue, item, API key or category as
+// protobuf JSON, so that exports can be read by any backend, or any tool.
+type exportRecord struct {
+	Type          string          `json:"type"`
+	SchemaVersion int             `json:"schema_version,omitempty"`
+	Queue         string          `json:"queue,omitempty"`
+	Key           string          `json:"key,omitempty"`
+	ItemSequence  uint64          `json:"item_sequence,omitempty"`
+	LeaseSequence uint64          `json:"lease_sequence,omitempty"`
+	Record        json.RawMessage `json:"record,omitempty"`
+}
+
+// ImportSummary counts the records added by Import.
+type ImportSummary struct {
+	Queues     int
+	Items      int
+	Finished   int
+	Keys       int
+	Categories int
+}
+
+// Snapshot writes a consistent copy of the store in its backend's file format, which can be
+// restored by replacing the store's file with it.
+func (s *store) Snapshot(w io.Writer) error {
+	return s.backend.snapshot(w)
+}
+
+// backupTo writes a snapshot of the store to a new file at the given path.
+func (s *store) backupTo(path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if err := s.Snapshot(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+// Export writes every queue, with its active items and history, the API keys and the categories
+// as lines of JSON.  Keys are exported with only the hashes of their secrets.  The export is taken in a
+// single transaction, so it is consistent, and is independent of the backend.
+func (s *store) Export(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	err := s.backend.view(func(tx txn) error {
+		if err := exportHeaderRecord(tx, enc); err != nil {
+			return err
+		}
+		queues, err := tx.queueKeys()
+		if err != nil {
+			return err
+		}
+		for _, q := range queues {
+			if err := exportQueueRecords(tx, enc, q); err != nil {
+				return fmt.Errorf("queue %v: %w", q, err)
+			}
+		}
+		keys, err := tx.keys()
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := encodeRecord(enc, exportRecord{Type: exportKey}, key); err != nil {
+				return err
+			}
+		}
+		categories, err := tx.categories()
+		if err != nil {
+			return err
+		}
+		for _, category := range categories {
+			if err := encodeRecord(enc, exportRecord{Type: exportCategory}, category); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// writeQueueExport writes an export of the single queue q, with its items and history.
+func writeQueueExport(tx txn, w io.Writer, q string) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := exportHeaderRecord(tx, enc); err != nil {
+		return err
+	}
+	if err := exportQueueRecords(tx, enc, q); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func exportHeaderRecord(tx txn, enc *json.Encoder) error {
+	version, err := schemaVersion(tx)
+	if err != nil {
+		return err
+	}
+	return enc.Encode(exportRecord{Type: exportHeader, SchemaVersion: version})
+}
+
+func exportQueueRecords(tx txn, enc *json.Encoder, q string) error {
+	meta, err := tx.getQueueMeta(q)
+	if err != nil {
+		return err
+	}
+	itemSeq, leaseSeq, err := tx.sequences(q)
+	if err != nil {
+		return err
+	}
+	if err := encodeRecord(enc, exportRecord{Type: exportQueue, Queue: q, ItemSequence: itemSeq, LeaseSequence: leaseSeq}, meta); err != nil {
+		return err
+	}
+
+	items, err := orderedItems(tx, q)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := encodeRecord(enc, exportRecord{Type: exportItem, Queue: q}, item); err != nil {
+			return err
+		}
+	}
+
+	var walkErr error
+	err = tx.walkFinished(q, "", false, func(key string, item *FinishedItem) bool {
+		walkErr = encodeRecord(enc, exportRecord{Type: exportFinished, Queue: q, Key: key}, item)
+		return walkErr == nil
+	})
+	return errors.Join(err, walkErr)
+}
+
+func encodeRecord(enc *json.Encoder, rec exportRecord, msg proto.Message) error {
+	bs, err := protojson.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("error marshalling %v: %w", rec.Type, err)
+	}
+	rec.Record = bs
+	return enc.Encode(rec)
+}
+
+// Import adds the queues in an export to the store, keeping their items' ids and keys.  The
+// export must have been taken at the store's schema version, and none of its queues may already
+// exist.  The import is made in a single transaction, so if it fails nothing is added.
+func (s *store) Import(r io.Reader) (*ImportSummary, error) {
+	summary := &ImportSummary{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxExportLine)
+	err := s.backend.update(func(tx txn) error {
+		imported := map[string]bool{}
+		for line := 1; scanner.Scan(); line++ {
+			var rec exportRecord
+			if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+				return fmt.Errorf("line %d: %v: %w", line, err, ErrInvalid{})
+			}
+			if line == 1 && rec.Type != exportHeader {
+				return fmt.Errorf("line %d: expected a header: %w", line, ErrInvalid{})
+			}
+			if (rec.Type == exportItem || rec.Type == exportFinished) && !imported[rec.Queue] {
+				return fmt.Errorf("line %d: %v record precedes its queue, %q: %w", line, rec.Type, rec.Queue, ErrInvalid{})
+			}
+			if err := importRecord(tx, rec, summary); err != nil {
+				return fmt.Errorf("line %d: %w", line, err)
+			}
+			if rec.Type == exportQueue {
+				imported[rec.Queue] = true
+			}
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func importRecord(tx txn, rec exportRecord, summary *ImportSummary) error {
+	switch rec.Type {
+	case exportHeader:
+		if rec.SchemaVersion != CurrentSchemaVersion() {
+			return fmt.Errorf("export has schema version %d, but the store has version %d: %w", rec.SchemaVersion, CurrentSchemaVersion(), ErrPrecondition{})
+		}
+		return nil
+
+	case exportQueue:
+		meta := &Queue{}
+		if err := protojson.Unmarshal(rec.Record, meta); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrInvalid{})
+		}
+		if meta.Id != rec.Queue {
+			return fmt.Errorf("queue record %q has id %q: %w", rec.Queue, meta.Id, ErrInvalid{})
+		}
+		if err := tx.createQueue(meta); err != nil {
+			return fmt.Errorf("queue %v: %w", meta.Id, err)
+		}
+		summary.Queues++
+		return tx.setSequences(meta.Id, rec.ItemSequence, rec.LeaseSequence)
+
+	case exportItem:
+		item := &Item{}
+		if err := protojson.Unmarshal(rec.Record, item); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrInvalid{})
+		}
+		if q, err := queueKeyFromItemID(item.Id); err != nil || q != rec.Queue {
+			return fmt.Errorf("item %q is not in queue %q: %w", item.Id, rec.Queue, ErrInvalid{})
+		}
+		summary.Items++
+		return tx.putItem(rec.Queue, item)
+
+	case exportFinished:
+		item := &FinishedItem{}
+		if err := protojson.Unmarshal(rec.Record, item); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrInvalid{})
+		}
+		if rec.Key == "" {
+			return fmt.Errorf("finished item has no key: %w", ErrInvalid{})
+		}
+		summary.Finished++
+		return tx.putFinished(rec.Queue, rec.Key, item)
+
+	case exportKey:
+		key := &ApiKey{}
+		if err := protojson.Unmarshal(rec.Record, key); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrInvalid{})
+		}
+		if _, err := tx.getKey(key.Name); err == nil {
+			return fmt.Errorf("key %v: %w", key.Name, ErrConflict{})
+		}
+		summary.Keys++
+		return tx.putKey(key)
+
+	case exportCategory:
+		category := &CategoryConfig{}
+		if err := protojson.Unmarshal(rec.Record, category); err != nil {
+			return fmt.Errorf("%v: %w", err, ErrInvalid{})
+		}
+		if _, err := tx.getCategory(category.Id); err == nil {
+			return fmt.Errorf("category %v: %w", category.Id, ErrConflict{})
+		}
+		summary.Categories++
+		return tx.putCategory(category)
+
+	default:
+		return fmt.Errorf("unknown record type %q: %w", rec.Type, ErrInvalid{})
+	}
+}
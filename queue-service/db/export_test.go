@@ -0,0 +1,86 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestStore_ExportImport(t *testing.T) {
+	src := openTestStore(t, BackendBolt, nil)
+	ids := enqueueTestItems(t, src, 0, 0, 5)
+	claimed, err := src.ClaimNextItem("q", "w")
+	if err != nil || claimed == nil {
+		t.Fatalf("unable to claim item: %v, %v", claimed, err)
+	}
+	if _, err := src.SetItemState(claimed.Id, claimed.Lease, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+		t.Fatalf("unable to complete item: %v", err)
+	}
+	if _, err := src.ClaimNextItem("q", "w"); err != nil {
+		t.Fatalf("unable to claim item: %v", err)
+	}
+	if err := src.CreateCategory(&CategoryConfig{Id: "iso", DestinationTemplate: "isos", AllowedHosts: []string{"example.com"}}); err != nil {
+		t.Fatalf("unable to create category: %v", err)
+	}
+
+	var export bytes.Buffer
+	if err := src.Export(&export); err != nil {
+		t.Fatalf("unable to export: %v", err)
+	}
+
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			dst, err := Open(backend, filepath.Join(t.TempDir(), "test.db"))
+			if err != nil {
+				t.Fatalf("unable to open store: %v", err)
+			}
+			t.Cleanup(func() {
+				dst.Close()
+			})
+			summary, err := dst.Import(bytes.NewReader(export.Bytes()))
+			if err != nil {
+				t.Fatalf("unable to import: %v", err)
+			}
+			if *summary != (ImportSummary{Queues: 1, Items: 2, Finished: 1, Categories: 1}) {
+				t.Errorf("unexpected summary: %+v", summary)
+			}
+
+			items, _, err := dst.GetQueueItems("q", ItemQuery{})
+			if err != nil {
+				t.Fatalf("unable to list items: %v", err)
+			}
+			var got []string
+			for _, item := range items {
+				got = append(got, item.Id)
+			}
+			if want := []string{ids[0], ids[1]}; !slices.Equal(got, want) {
+				t.Errorf("expected items %v, got %v", want, got)
+			}
+			finished, _, err := dst.GetFinishedItems("q", ItemQuery{})
+			if err != nil || len(finished) != 1 || finished[0].Item.Id != ids[2] {
+				t.Errorf("expected %v in the history, got %v, %v", ids[2], finished, err)
+			}
+			category, err := dst.GetCategory("iso")
+			if err != nil || category.DestinationTemplate != "isos" || !slices.Equal(category.AllowedHosts, []string{"example.com"}) {
+				t.Errorf("expected the category to be imported, got %v, %v", category, err)
+			}
+
+			// the queue's sequences carry on from where they were
+			next := enqueueTestItems(t, dst, 0)
+			if next[0] <= ids[2] {
+				t.Errorf("expected a new id after %v, got %v", ids[2], next[0])
+			}
+
+			// a second import conflicts, and adds nothing
+			if _, err := dst.Import(bytes.NewReader(export.Bytes())); !errors.As(err, &ErrConflict{}) {
+				t.Errorf("expected the import to conflict, got %v", err)
+			}
+			if items, _, err := dst.GetQueueItems("q", ItemQuery{}); err != nil || len(items) != 3 {
+				t.Errorf("expected the failed import to leave 3 items, got %v, %v", items, err)
+			}
+		})
+	}
+}
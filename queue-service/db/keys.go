@@ -0,0 +1,50 @@
+package db
+
+// ListKeys returns the store's API keys, ordered by name.
+func (s *store) ListKeys() ([]*ApiKey, error) {
+	var out []*ApiKey
+	err := s.backend.view(func(tx txn) error {
+		var err error
+		out, err = tx.keys()
+		return err
+	})
+	return out, err
+}
+
+// CreateKey stores a new API key.  It returns ErrConflict if a key with the same name exists.
+func (s *store) CreateKey(key *ApiKey) error {
+	return s.backend.update(func(tx txn) error {
+		if _, err := tx.getKey(key.Name); err == nil {
+			return ErrConflict{}
+		}
+		return tx.putKey(key)
+	})
+}
+
+// UpdateKey changes the named key by applying update to it, and returns the updated key.  If
+// update returns an error, the key is left unchanged.
+func (s *store) UpdateKey(name string, update func(key *ApiKey) error) (*ApiKey, error) {
+	var out *ApiKey
+	err := s.backend.update(func(tx txn) error {
+		key, err := tx.getKey(name)
+		if err != nil {
+			return err
+		}
+		if err := update(key); err != nil {
+			return err
+		}
+		out = key
+		return tx.putKey(key)
+	})
+	return out, err
+}
+
+// DeleteKey removes the named key.
+func (s *store) DeleteKey(name string) error {
+	return s.backend.update(func(tx txn) error {
+		if _, err := tx.getKey(name); err != nil {
+			return err
+		}
+		return tx.deleteKey(name)
+	})
+}
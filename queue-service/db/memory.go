@@ -0,0 +1,442 @@
+package db
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"io"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NewMemory returns an empty store which is held in memory, and lost when the process exits.
+func NewMemory() *Memory {
+	out := &Memory{
+		state: &memoryState{
+			version:    CurrentSchemaVersion(),
+			queues:     map[string]*memoryQueue{},
+			keys:       map[string][]byte{},
+			categories: map[string][]byte{},
+		},
+	}
+	out.store.backend = out
+	return out
+}
+
+// Memory is a store held in memory.  Records are kept marshalled, as they would be on disk, so
+// that callers can't change them other than through a transaction.
+type Memory struct {
+	store
+	lock  sync.RWMutex
+	state *memoryState
+}
+
+type memoryState struct {
+	version    int
+	queues     map[string]*memoryQueue
+	keys       map[string][]byte
+	categories map[string][]byte
+}
+
+type memoryQueue struct {
+	meta     []byte
+	itemSeq  uint64
+	leaseSeq uint64
+	items    map[string][]byte
+	finished map[string][]byte
+	// finishedIndex maps the ids of finished items to their keys in finished.
+	finishedIndex map[string]string
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// snapshot fails, as the store isn't held in a file.  Export gives a copy of the store which
+// can be imported into another.
+func (m *Memory) snapshot(io.Writer) error {
+	return fmt.Errorf("the memory store can't be snapshotted, only exported: %w", ErrPrecondition{})
+}
+
+func (m *Memory) view(fn func(tx txn) error) error {
+	defer observeTransaction(BackendMemory, transactionView, time.Now())
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return fn(&memoryTxn{state: m.state})
+}
+
+// update runs fn against a copy of the store's state, which replaces the state only if fn
+// succeeds.
+func (m *Memory) update(fn func(tx txn) error) error {
+	defer observeTransaction(BackendMemory, transactionUpdate, time.Now())
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	next := m.state.clone()
+	if err := fn(&memoryTxn{state: next}); err != nil {
+		return err
+	}
+	m.state = next
+	return nil
+}
+
+// clone copies the state.  The marshalled records themselves are never modified, so they are
+// shared.
+func (s *memoryState) clone() *memoryState {
+	out := &memoryState{
+		version:    s.version,
+		queues:     make(map[string]*memoryQueue, len(s.queues)),
+		keys:       maps.Clone(s.keys),
+		categories: maps.Clone(s.categories),
+	}
+	for k, q := range s.queues {
+		out.queues[k] = &memoryQueue{
+			meta:          q.meta,
+			itemSeq:       q.itemSeq,
+			leaseSeq:      q.leaseSeq,
+			items:         maps.Clone(q.items),
+			finished:      maps.Clone(q.finished),
+			finishedIndex: maps.Clone(q.finishedIndex),
+		}
+	}
+	return out
+}
+
+type memoryTxn struct {
+	state *memoryState
+}
+
+func (t *memoryTxn) queue(q string) (*memoryQueue, error) {
+	out, ok := t.state.queues[q]
+	if !ok {
+		return nil, ErrNotFound{}
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) schemaVersion() (int, error) {
+	return t.state.version, nil
+}
+
+func (t *memoryTxn) setSchemaVersion(version int) error {
+	t.state.version = version
+	return nil
+}
+
+func (t *memoryTxn) createQueue(meta *Queue) error {
+	if _, ok := t.state.queues[meta.Id]; ok {
+		return ErrConflict{}
+	}
+	t.state.queues[meta.Id] = &memoryQueue{
+		itemSeq:       firstSequence,
+		items:         map[string][]byte{},
+		finished:      map[string][]byte{},
+		finishedIndex: map[string]string{},
+	}
+	return t.putQueueMeta(meta)
+}
+
+func (t *memoryTxn) queueKeys() ([]string, error) {
+	out := make([]string, 0, len(t.state.queues))
+	for k := range t.state.queues {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func (t *memoryTxn) deleteQueue(q string) error {
+	if _, err := t.queue(q); err != nil {
+		return err
+	}
+	delete(t.state.queues, q)
+	return nil
+}
+
+func (t *memoryTxn) getQueueMeta(q string) (*Queue, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return nil, err
+	}
+	var out Queue
+	if err := proto.Unmarshal(mq.meta, &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling queue object: %w", err)
+	}
+	return &out, nil
+}
+
+func (t *memoryTxn) putQueueMeta(meta *Queue) error {
+	mq, err := t.queue(meta.Id)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue object: %w", err)
+	}
+	mq.meta = bs
+	return nil
+}
+
+func (t *memoryTxn) nextSequence(q string) (uint64, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return 0, err
+	}
+	mq.itemSeq++
+	return mq.itemSeq, nil
+}
+
+func (t *memoryTxn) nextLease(q string) (uint64, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return 0, err
+	}
+	mq.leaseSeq++
+	return mq.leaseSeq, nil
+}
+
+func (t *memoryTxn) sequences(q string) (uint64, uint64, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return 0, 0, err
+	}
+	return mq.itemSeq, mq.leaseSeq, nil
+}
+
+func (t *memoryTxn) setSequences(q string, item uint64, lease uint64) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	mq.itemSeq, mq.leaseSeq = item, lease
+	return nil
+}
+
+func (t *memoryTxn) getItem(q string, id string) (*Item, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return nil, err
+	}
+	bs, ok := mq.items[id]
+	if !ok {
+		return nil, ErrNotFound{}
+	}
+	out := &Item{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling item: %w", err)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) items(q string) ([]*Item, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Item, 0, len(mq.items))
+	for id, bs := range mq.items {
+		item := &Item{}
+		if err := proto.Unmarshal(bs, item); err != nil {
+			return nil, fmt.Errorf("error unmarshalling item %v: %w", id, err)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) putItem(q string, item *Item) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("error marshalling item: %w", err)
+	}
+	mq.items[item.Id] = bs
+	return nil
+}
+
+func (t *memoryTxn) deleteItem(q string, id string) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	delete(mq.items, id)
+	return nil
+}
+
+func (t *memoryTxn) putFinished(q string, key string, item *FinishedItem) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("unable to marshal finished item: %w", err)
+	}
+	mq.finished[key] = bs
+	mq.finishedIndex[item.GetItem().GetId()] = key
+	return nil
+}
+
+func (t *memoryTxn) getFinished(q string, id string) (*FinishedItem, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := mq.finishedIndex[id]
+	if !ok {
+		return nil, ErrNotFound{}
+	}
+	var out FinishedItem
+	if err := proto.Unmarshal(mq.finished[key], &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling finished item: %w", err)
+	}
+	return &out, nil
+}
+
+func (t *memoryTxn) walkFinished(q string, after string, descending bool, fn func(key string, item *FinishedItem) bool) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(mq.finished))
+	for k := range mq.finished {
+		keys = append(keys, k)
+	}
+	if descending {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	} else {
+		sort.Strings(keys)
+	}
+
+	for _, k := range keys {
+		if after != "" && (descending && k >= after || !descending && k <= after) {
+			continue
+		}
+		item := &FinishedItem{}
+		if err := proto.Unmarshal(mq.finished[k], item); err != nil {
+			return fmt.Errorf("unmarshalling item: %w", err)
+		}
+		if !fn(k, item) {
+			break
+		}
+	}
+	return nil
+}
+
+func (t *memoryTxn) deleteFinished(q string, key string, id string) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	delete(mq.finished, key)
+	delete(mq.finishedIndex, id)
+	return nil
+}
+
+func (t *memoryTxn) clearFinished(q string) error {
+	mq, err := t.queue(q)
+	if err != nil {
+		return err
+	}
+	mq.finished = map[string][]byte{}
+	mq.finishedIndex = map[string]string{}
+	return nil
+}
+
+func (t *memoryTxn) finishedCount(q string) (int, error) {
+	mq, err := t.queue(q)
+	if err != nil {
+		return 0, err
+	}
+	return len(mq.finished), nil
+}
+
+func (t *memoryTxn) getKey(name string) (*ApiKey, error) {
+	bs, ok := t.state.keys[name]
+	if !ok {
+		return nil, ErrNotFound{}
+	}
+	out := &ApiKey{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling key: %w", err)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) keys() ([]*ApiKey, error) {
+	names := make([]string, 0, len(t.state.keys))
+	for name := range t.state.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]*ApiKey, 0, len(names))
+	for _, name := range names {
+		key, err := t.getKey(name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, key)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) putKey(key *ApiKey) error {
+	bs, err := proto.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("error marshalling key: %w", err)
+	}
+	t.state.keys[key.Name] = bs
+	return nil
+}
+
+func (t *memoryTxn) deleteKey(name string) error {
+	delete(t.state.keys, name)
+	return nil
+}
+
+func (t *memoryTxn) getCategory(name string) (*CategoryConfig, error) {
+	bs, ok := t.state.categories[name]
+	if !ok {
+		return nil, ErrNotFound{}
+	}
+	out := &CategoryConfig{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling category: %w", err)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) categories() ([]*CategoryConfig, error) {
+	names := make([]string, 0, len(t.state.categories))
+	for name := range t.state.categories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]*CategoryConfig, 0, len(names))
+	for _, name := range names {
+		category, err := t.getCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, category)
+	}
+	return out, nil
+}
+
+func (t *memoryTxn) putCategory(category *CategoryConfig) error {
+	bs, err := proto.Marshal(category)
+	if err != nil {
+		return fmt.Errorf("error marshalling category: %w", err)
+	}
+	t.state.categories[category.Id] = bs
+	return nil
+}
+
+func (t *memoryTxn) deleteCategory(name string) error {
+	delete(t.state.categories, name)
+	return nil
+}
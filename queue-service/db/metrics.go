@@ -0,0 +1,45 @@
+package db
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+// Kinds of transaction, as reported by transactionDuration.
+const (
+	transactionView   = "view"
+	transactionUpdate = "update"
+)
+
+var (
+	transactionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "godm",
+		Subsystem: "store",
+		Name:      "transaction_duration_seconds",
+		Help:      "The time taken by store transactions, including waiting for the store's lock.",
+		Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"backend", "kind"})
+	claims = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "queue",
+		Name:      "claims_total",
+		Help:      "The number of items claimed.",
+	}, []string{"queue"})
+	expiredClaims = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "queue",
+		Name:      "expired_claims_total",
+		Help:      "The number of items claimed again after the claim before expired without the item finishing.",
+	}, []string{"queue"})
+	prunedItems = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "godm",
+		Subsystem: "queue",
+		Name:      "history_pruned_total",
+		Help:      "The number of finished items removed from the history by the queue's retention policy.",
+	}, []string{"queue"})
+)
+
+func observeTransaction(backend string, kind string, start time.Time) {
+	transactionDuration.WithLabelValues(backend, kind).Observe(time.Since(start).Seconds())
+}
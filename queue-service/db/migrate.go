@@ -0,0 +1,171 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// legacySchemaVersion is the version of stores written before versions were recorded.
+const legacySchemaVersion = 1
+
+// Migration is a change to the way a store's records are held, which brings a store written at
+// the previous version up to Version.
+type Migration struct {
+	Version     int
+	Description string
+	apply       func(tx txn) error
+}
+
+// migrations lists every migration in the order it is applied.  A change to the stored records
+// which existing stores can't be read with is made by appending a migration here.
+var migrations = []Migration{
+	{
+		Version:     2,
+		Description: "index finished items by id",
+		apply:       indexFinishedItems,
+	},
+}
+
+// CurrentSchemaVersion returns the version of the records written by this build.
+func CurrentSchemaVersion() int {
+	return migrations[len(migrations)-1].Version
+}
+
+// MigrateOptions controls how a store is migrated.
+type MigrateOptions struct {
+	// DryRun applies the migrations and then discards their changes, so that they can be checked
+	// without changing the store.
+	DryRun bool
+	// BackupPath is the file to which the store is copied before it is migrated.  If it is empty,
+	// no backup is taken.
+	BackupPath string
+}
+
+// errDryRun rolls back the transaction in which a dry run's migrations are applied.
+var errDryRun = errors.New("dry run")
+
+// SchemaVersion returns the version of the store's records.
+func (s *store) SchemaVersion() (int, error) {
+	var out int
+	err := s.backend.view(func(tx txn) error {
+		var err error
+		out, err = schemaVersion(tx)
+		return err
+	})
+	return out, err
+}
+
+// Migrate applies the migrations which the store is yet to have, in order, and returns them.  The
+// migrations are applied in a single transaction, so if any fails the store is left as it was.
+func (s *store) Migrate(opts MigrateOptions) ([]Migration, error) {
+	from, err := s.SchemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	pending := pendingMigrations(from)
+	if len(pending) == 0 {
+		return nil, nil
+	}
+	if opts.BackupPath != "" && !opts.DryRun {
+		if err := s.backupTo(opts.BackupPath); err != nil {
+			return nil, fmt.Errorf("error backing up store to %v: %w", opts.BackupPath, err)
+		}
+	}
+
+	err = s.backend.update(func(tx txn) error {
+		for _, m := range pending {
+			if err := m.apply(tx); err != nil {
+				return fmt.Errorf("error applying migration %d, %s: %w", m.Version, m.Description, err)
+			}
+		}
+		if err := tx.setSchemaVersion(CurrentSchemaVersion()); err != nil {
+			return fmt.Errorf("error recording schema version: %w", err)
+		}
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// pendingMigrations returns the migrations which apply to a store at the given version.
+func pendingMigrations(version int) []Migration {
+	for i, m := range migrations {
+		if m.Version > version {
+			return migrations[i:]
+		}
+	}
+	return nil
+}
+
+// schemaVersion returns the version of the records in the store.  A store without a recorded
+// version is either empty, in which case there is nothing to migrate, or predates versioning.
+func schemaVersion(tx txn) (int, error) {
+	version, err := tx.schemaVersion()
+	if err != nil || version != 0 {
+		return version, err
+	}
+	queues, err := tx.queueKeys()
+	if err != nil {
+		return 0, err
+	}
+	if len(queues) == 0 {
+		return CurrentSchemaVersion(), nil
+	}
+	return legacySchemaVersion, nil
+}
+
+// initSchema is called as a store is opened.  It records the version of a new store, so that the
+// records written to it aren't mistaken for those of a legacy store, and refuses to open a store
+// written by a newer build, whose records it may not understand.
+func (s *store) initSchema() error {
+	return s.backend.update(func(tx txn) error {
+		version, err := schemaVersion(tx)
+		if err != nil {
+			return err
+		}
+		if version > CurrentSchemaVersion() {
+			return fmt.Errorf("store has schema version %d, but this build only understands up to version %d", version, CurrentSchemaVersion())
+		}
+		recorded, err := tx.schemaVersion()
+		if err != nil {
+			return err
+		}
+		if recorded == 0 && version == CurrentSchemaVersion() {
+			return tx.setSchemaVersion(version)
+		}
+		return nil
+	})
+}
+
+// indexFinishedItems adds the finished items written before the history was indexed by id to
+// the index, so that items can depend on them.
+func indexFinishedItems(tx txn) error {
+	queues, err := tx.queueKeys()
+	if err != nil {
+		return err
+	}
+	for _, q := range queues {
+		// the history can't be written while it is being walked, so collect it first
+		var keys []string
+		var items []*FinishedItem
+		err := tx.walkFinished(q, "", false, func(key string, item *FinishedItem) bool {
+			keys = append(keys, key)
+			items = append(items, item)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("queue %v: %w", q, err)
+		}
+		for i, key := range keys {
+			if err := tx.putFinished(q, key, items[i]); err != nil {
+				return fmt.Errorf("queue %v: %w", q, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"errors"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBolt_Migrate(t *testing.T) {
+	b := openTestStore(t, BackendBolt, nil).(*Bolt)
+	ids := enqueueTestItems(t, b, 0)
+	claimed, err := b.ClaimNextItem("q", "w")
+	if err != nil || claimed == nil {
+		t.Fatalf("unable to claim item: %v, %v", claimed, err)
+	}
+	if _, err := b.CompleteItem(ids[0], claimed.Lease, 10); err != nil {
+		t.Fatalf("unable to complete item: %v", err)
+	}
+
+	// make the database look like one written before versions were recorded, when the history
+	// wasn't indexed
+	err = b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket([]byte(SchemaBucket)); err != nil {
+			return err
+		}
+		return tx.Bucket([]byte(QueueBucket)).Bucket([]byte("q")).DeleteBucket([]byte(FinishedIndexBucket))
+	})
+	if err != nil {
+		t.Fatalf("unable to rewrite database: %v", err)
+	}
+	if v, err := b.SchemaVersion(); err != nil || v != legacySchemaVersion {
+		t.Fatalf("expected the legacy version, got %v, %v", v, err)
+	}
+	dependent := &Item{
+		Source:      &Target{Url: "http://example.com"},
+		Destination: &Target{Url: "file://example"},
+		DependsOn:   ids,
+	}
+	if _, err := b.EnqueueItem("q", dependent); !errors.As(err, &ErrNotFound{}) {
+		t.Fatalf("expected the unindexed item not to be found, got %v", err)
+	}
+
+	backup := filepath.Join(t.TempDir(), "backup.db")
+	applied, err := b.Migrate(MigrateOptions{DryRun: true, BackupPath: backup})
+	if err != nil || len(applied) != len(migrations) {
+		t.Fatalf("expected every migration to be checked, got %v, %v", applied, err)
+	}
+	if v, err := b.SchemaVersion(); err != nil || v != legacySchemaVersion {
+		t.Errorf("expected a dry run to leave the version alone, got %v, %v", v, err)
+	}
+	if _, err := os.Stat(backup); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected a dry run not to take a backup, got %v", err)
+	}
+
+	applied, err = b.Migrate(MigrateOptions{BackupPath: backup})
+	if err != nil || len(applied) != len(migrations) {
+		t.Fatalf("expected every migration to be applied, got %v, %v", applied, err)
+	}
+	if v, err := b.SchemaVersion(); err != nil || v != CurrentSchemaVersion() {
+		t.Errorf("expected the current version, got %v, %v", v, err)
+	}
+	if _, err := b.EnqueueItem("q", dependent); err != nil {
+		t.Errorf("expected the migrated history to satisfy the dependency, got %v", err)
+	}
+	if applied, err := b.Migrate(MigrateOptions{}); err != nil || len(applied) != 0 {
+		t.Errorf("expected nothing left to migrate, got %v, %v", applied, err)
+	}
+
+	old, err := NewBolt(backup)
+	if err != nil {
+		t.Fatalf("unable to open backup: %v", err)
+	}
+	defer old.Close()
+	if v, err := old.SchemaVersion(); err != nil || v != legacySchemaVersion {
+		t.Errorf("expected the backup to hold the legacy database, got %v, %v", v, err)
+	}
+}
+
+func TestBolt_NewerSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.db")
+	b, err := NewBolt(path)
+	if err != nil {
+		t.Fatalf("unable to open database: %v", err)
+	}
+	err = b.update(func(tx txn) error {
+		return tx.setSchemaVersion(CurrentSchemaVersion() + 1)
+	})
+	b.Close()
+	if err != nil {
+		t.Fatalf("unable to set version: %v", err)
+	}
+	if _, err := NewBolt(path); err == nil {
+		t.Errorf("expected a database from a newer build to be refused")
+	}
+}
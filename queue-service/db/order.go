@@ -0,0 +1,152 @@
+package db
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	// positionSpacing is the gap left between the positions of consecutively enqueued items,
+	// so that items can be moved between them without renumbering the queue.
+	positionSpacing = 1 << 20
+)
+
+// compareItems orders items by descending priority, then by ascending position.  Items which
+// share a priority and position are ordered by id, which reflects the order they were added.
+func compareItems(a, b *Item) int {
+	if c := cmp.Compare(b.Priority, a.Priority); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Position, b.Position); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Id, b.Id)
+}
+
+// orderedItems returns all items in queue q in the order in which they are to be downloaded.
+func orderedItems(tx txn, q string) ([]*Item, error) {
+	out, err := tx.items(q)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(out, compareItems)
+	return out, nil
+}
+
+// placement decides where an item being moved should go.  It is given the ordered items in the
+// queue, excluding the item being moved, and returns the priority the item should take and the
+// index in others at which it should be inserted.
+type placement func(item *Item, others []*Item) (priority int32, index int, err error)
+
+func placeAtFront(item *Item, others []*Item) (int32, int, error) {
+	priority := item.Priority
+	if len(others) > 0 {
+		priority = max(priority, others[0].Priority)
+	}
+	return priority, 0, nil
+}
+
+func placeAtBack(item *Item, others []*Item) (int32, int, error) {
+	priority := item.Priority
+	if len(others) > 0 {
+		priority = min(priority, others[len(others)-1].Priority)
+	}
+	return priority, len(others), nil
+}
+
+func placeNextTo(relativeTo string, after bool) placement {
+	return func(item *Item, others []*Item) (int32, int, error) {
+		idx := slices.IndexFunc(others, func(other *Item) bool {
+			return other.Id == relativeTo
+		})
+		if idx < 0 {
+			return 0, 0, ErrNotFound{}
+		}
+		priority := others[idx].Priority
+		if after {
+			idx++
+		}
+		return priority, idx, nil
+	}
+}
+
+// positionBetween returns a position which lies between prev and next, either of which may be
+// nil.  If there is no room between the two, ok is false and the queue must be renumbered.
+func positionBetween(prev, next *Item, current int64) (position int64, ok bool) {
+	switch {
+	case prev == nil && next == nil:
+		return current, true
+
+	case next == nil:
+		return prev.Position + positionSpacing, true
+
+	case prev == nil:
+		return next.Position - positionSpacing, true
+
+	case next.Position-prev.Position < 2:
+		return 0, false
+
+	default:
+		return prev.Position + (next.Position-prev.Position)/2, true
+	}
+}
+
+// moveItem repositions the item with the given id within its queue, according to place.
+func (s *store) moveItem(id string, place placement) (*Item, error) {
+	var out *Item
+	err := s.backend.update(func(tx txn) error {
+		q, err := queueKeyFromItemID(id)
+		if err != nil {
+			return ErrInvalid{}
+		}
+		ordered, err := orderedItems(tx, q)
+		if err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(ordered, func(item *Item) bool {
+			return item.Id == id
+		})
+		if idx < 0 {
+			return ErrNotFound{}
+		}
+		item := ordered[idx]
+		others := slices.Delete(slices.Clone(ordered), idx, idx+1)
+
+		priority, insertAt, err := place(item, others)
+		if err != nil {
+			return err
+		}
+		item.Priority = priority
+
+		var prev, next *Item
+		if insertAt > 0 && others[insertAt-1].Priority == priority {
+			prev = others[insertAt-1]
+		}
+		if insertAt < len(others) && others[insertAt].Priority == priority {
+			next = others[insertAt]
+		}
+
+		position, ok := positionBetween(prev, next, item.Position)
+		if ok {
+			item.Position = position
+			out = item
+			return tx.putItem(q, item)
+		}
+
+		// there's no gap to put the item in, so spread the whole queue out again
+		reordered := slices.Insert(others, insertAt, item)
+		for i, it := range reordered {
+			it.Position = int64(i+1) * positionSpacing
+			if err := tx.putItem(q, it); err != nil {
+				return err
+			}
+		}
+		out = item
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
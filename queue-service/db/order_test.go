@@ -0,0 +1,157 @@
+package db
+
+import (
+	"slices"
+	"testing"
+)
+
+func enqueueTestItems(t *testing.T, b Store, priorities ...int32) []string {
+	t.Helper()
+	var ids []string
+	for _, p := range priorities {
+		id, err := b.EnqueueItem("q", &Item{
+			Source:      &Target{Url: "http://example.com"},
+			Destination: &Target{Url: "file://example"},
+			Category:    &Category{Id: "default"},
+			Priority:    p,
+		})
+		if err != nil {
+			t.Fatalf("unable to enqueue item: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func queueOrder(t *testing.T, b Store) []string {
+	t.Helper()
+	items, _, err := b.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+	if err != nil {
+		t.Fatalf("unable to get queue items: %v", err)
+	}
+	var out []string
+	for _, item := range items {
+		out = append(out, item.Id)
+	}
+	return out
+}
+
+func TestStore_Ordering(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 5, 0, 5)
+
+			if got, want := queueOrder(t, s), []string{ids[1], ids[3], ids[0], ids[2]}; !slices.Equal(got, want) {
+				t.Errorf("expected order %v, got %v", want, got)
+			}
+
+			item, err := s.ClaimNextItem("q", "")
+			if err != nil {
+				t.Fatalf("unexpected error claiming item: %v", err)
+			}
+			if item.Id != ids[1] {
+				t.Errorf("expected to claim %v, got %v", ids[1], item.Id)
+			}
+			item, err = s.ClaimNextItem("q", "")
+			if err != nil {
+				t.Fatalf("unexpected error claiming item: %v", err)
+			}
+			if item.Id != ids[3] {
+				t.Errorf("expected to claim %v, got %v", ids[3], item.Id)
+			}
+		})
+	}
+}
+
+func TestStore_Move(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 0, 0, 1)
+
+			steps := []struct {
+				name string
+				move func() (*Item, error)
+				want []string
+			}{
+				{
+					name: "to front raises priority",
+					move: func() (*Item, error) { return s.MoveToFront(ids[2]) },
+					want: []string{ids[2], ids[3], ids[0], ids[1]},
+				},
+				{
+					name: "to back lowers priority",
+					move: func() (*Item, error) { return s.MoveToBack(ids[3]) },
+					want: []string{ids[2], ids[0], ids[1], ids[3]},
+				},
+				{
+					name: "after takes priority of neighbour",
+					move: func() (*Item, error) { return s.MoveItem(ids[2], ids[0], true) },
+					want: []string{ids[0], ids[2], ids[1], ids[3]},
+				},
+				{
+					name: "before",
+					move: func() (*Item, error) { return s.MoveItem(ids[3], ids[0], false) },
+					want: []string{ids[3], ids[0], ids[2], ids[1]},
+				},
+			}
+
+			for _, step := range steps {
+				if _, err := step.move(); err != nil {
+					t.Fatalf("%s: unexpected error: %v", step.name, err)
+				}
+				if got := queueOrder(t, s); !slices.Equal(got, step.want) {
+					t.Errorf("%s: expected order %v, got %v", step.name, step.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_MoveRenumbers(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 0, 0)
+
+			// alternately moving the last two items in between the first and the other halves the gap
+			// each time, so this will eventually force the queue to be renumbered.
+			for i := 0; i < 64; i++ {
+				mover, other := ids[1], ids[2]
+				if i%2 == 1 {
+					mover, other = ids[2], ids[1]
+				}
+				want := []string{ids[0], mover, other}
+				if _, err := s.MoveItem(mover, ids[0], true); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got := queueOrder(t, s); !slices.Equal(got, want) {
+					t.Fatalf("move %v: expected order %v, got %v", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_MoveErrors(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 0)
+
+			if _, err := s.MoveItem(ids[0], ids[0], true); err == nil {
+				t.Errorf("expected an error moving an item relative to itself")
+			}
+			if _, err := s.MoveItem(ids[0], "q:00000000000000000099", true); err == nil {
+				t.Errorf("expected an error moving relative to an unknown item")
+			}
+			if _, err := s.MoveItem(ids[0], "other:00000000000000000001", true); err == nil {
+				t.Errorf("expected an error moving relative to an item in another queue")
+			}
+			if _, err := s.MoveToFront("q:00000000000000000099"); err == nil {
+				t.Errorf("expected an error moving an unknown item")
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package db
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/queue"
+	"math"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SortField identifies the field by which a listing of items is ordered.
+type SortField int
+
+const (
+	// SortDefault is the natural order of a listing.  Active items are listed in the order in
+	// which they are to be downloaded, and finished items in the order in which they finished.
+	SortDefault SortField = iota
+	SortUpdated
+	SortSource
+	SortDestination
+	SortCategory
+	SortTotalSize
+)
+
+// ItemQuery describes which page of which items a listing should return.
+type ItemQuery struct {
+	Filter     ItemFilter
+	Sort       SortField
+	Descending bool
+	// Cursor is the value returned by the previous call, or empty for the first page.
+	Cursor   string
+	PageSize uint
+}
+
+// Limit returns the most items a page of the listing holds: PageSize, or DefaultPageSize if it
+// is zero, but no more than MaxPageSize.
+func (q ItemQuery) Limit() uint {
+	return min(defaultIfEmpty(DefaultPageSize, q.PageSize), MaxPageSize)
+}
+
+// ItemFilter restricts a listing to the items which match all of its non-zero fields.
+type ItemFilter struct {
+	States            []queue.ItemState_State
+	Category          string
+	SourceHost        string
+	DestinationPrefix string
+	UpdatedBefore     time.Time
+	UpdatedAfter      time.Time
+}
+
+// cursor records the position of the last item returned by a listing.  It is handed to
+// clients in an encoded form, which they should treat as opaque.
+type cursor struct {
+	Sort       SortField `json:"s"`
+	Descending bool      `json:"d,omitempty"`
+	Value      string    `json:"v,omitempty"`
+	Key        string    `json:"k"`
+}
+
+func (c cursor) encode() string {
+	bs, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func decodeCursor(q ItemQuery) (cursor, error) {
+	if q.Cursor == "" {
+		return cursor{Sort: q.Sort, Descending: q.Descending}, nil
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(q.Cursor)
+	if err != nil {
+		return cursor{}, ErrInvalid{}
+	}
+	var out cursor
+	if err := json.Unmarshal(bs, &out); err != nil {
+		return cursor{}, ErrInvalid{}
+	}
+	if out.Sort != q.Sort || out.Descending != q.Descending {
+		// the cursor's position means nothing in a different ordering
+		return cursor{}, ErrInvalid{}
+	}
+	return out, nil
+}
+
+// after reports whether an item with the given sort value and key lies beyond the cursor.
+func (c cursor) after(value, key string) bool {
+	res := cmp.Or(strings.Compare(value, c.Value), strings.Compare(key, c.Key))
+	if c.Descending {
+		return res < 0
+	}
+	return res > 0
+}
+
+// listed describes how to read, filter and order a type of item held in a queue.
+type listed[V any] struct {
+	// walk calls fn with the queue's items in key order, beginning after the given key, or at
+	// the start if it is empty, until fn returns false.
+	walk func(tx txn, q string, after string, descending bool, fn func(key string, v V) bool) error
+	// fields returns the values of v against which a filter is matched.
+	fields func(v V) itemFields
+	// sortValue returns a string for v whose lexicographic order reflects the sort field.  Items
+	// with equal values are ordered by their key.
+	sortValue func(v V, field SortField) string
+	// keyOrdered indicates that the default order is the order of the items' keys, so that
+	// listings can walk the items rather than loading and sorting every one.
+	keyOrdered bool
+}
+
+// itemFields are the attributes of an item that an ItemFilter can match against.
+type itemFields struct {
+	state       queue.ItemState_State
+	category    string
+	source      string
+	destination string
+	updated     time.Time
+}
+
+func (f ItemFilter) matches(fields itemFields) bool {
+	if len(f.States) > 0 && !slices.Contains(f.States, fields.state) {
+		return false
+	}
+	if f.Category != "" && f.Category != fields.category {
+		return false
+	}
+	if f.SourceHost != "" {
+		u, err := url.Parse(fields.source)
+		if err != nil || !strings.EqualFold(u.Hostname(), f.SourceHost) {
+			return false
+		}
+	}
+	if f.DestinationPrefix != "" && !strings.HasPrefix(fields.destination, f.DestinationPrefix) {
+		return false
+	}
+	if !f.UpdatedBefore.IsZero() && !fields.updated.Before(f.UpdatedBefore) {
+		return false
+	}
+	if !f.UpdatedAfter.IsZero() && fields.updated.Before(f.UpdatedAfter) {
+		return false
+	}
+	return true
+}
+
+// commonSortValue returns the sort value for the fields shared by active and finished items.
+func commonSortValue(fields itemFields, totalSizeBytes uint64, field SortField) string {
+	switch field {
+	case SortUpdated:
+		return fmt.Sprintf("%020d", uint64(fields.updated.UnixNano()))
+	case SortSource:
+		return fields.source
+	case SortDestination:
+		return fields.destination
+	case SortCategory:
+		return fields.category
+	case SortTotalSize:
+		return fmt.Sprintf("%020d", totalSizeBytes)
+	default:
+		return ""
+	}
+}
+
+func activeItemFields(item *Item) itemFields {
+	return itemFields{
+		state:       item.State(time.Now()),
+		category:    item.GetCategory().GetId(),
+		source:      item.GetSource().GetUrl(),
+		destination: item.GetDestination().GetUrl(),
+		updated:     item.Updated.AsTime(),
+	}
+}
+
+func finishedItemFields(item *FinishedItem) itemFields {
+	return itemFields{
+		state:       item.ItemState(),
+		category:    item.GetItem().GetCategory().GetId(),
+		source:      item.GetItem().GetSource().GetUrl(),
+		destination: item.GetItem().GetDestination().GetUrl(),
+		updated:     item.Timestamp.AsTime(),
+	}
+}
+
+var activeItems = listed[*Item]{
+	walk:   walkActive,
+	fields: activeItemFields,
+	sortValue: func(item *Item, field SortField) string {
+		if field == SortDefault {
+			// descending priority, then ascending position, as fixed-width numbers
+			priority := uint64(math.MaxInt32 - int64(item.Priority))
+			position := uint64(item.Position) ^ (1 << 63)
+			return fmt.Sprintf("%010d%020d", priority, position)
+		}
+		return commonSortValue(activeItemFields(item), item.TotalSizeBytes, field)
+	},
+}
+
+var finishedItems = listed[*FinishedItem]{
+	walk: func(tx txn, q string, after string, descending bool, fn func(key string, item *FinishedItem) bool) error {
+		return tx.walkFinished(q, after, descending, fn)
+	},
+	fields:     finishedItemFields,
+	keyOrdered: true,
+	sortValue: func(item *FinishedItem, field SortField) string {
+		// finished items are keyed by the time they finished, so the default order is the key
+		// order, which an empty value leaves to decide.
+		return commonSortValue(finishedItemFields(item), item.TotalSizeBytes, field)
+	},
+}
+
+// walkActive walks the queue's active items, which are keyed by their ids.
+func walkActive(tx txn, q string, after string, descending bool, fn func(key string, item *Item) bool) error {
+	items, err := tx.items(q)
+	if err != nil {
+		return err
+	}
+	slices.SortFunc(items, func(a, b *Item) int {
+		if descending {
+			return strings.Compare(b.Id, a.Id)
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	for _, item := range items {
+		if after != "" && (descending && item.Id >= after || !descending && item.Id <= after) {
+			continue
+		}
+		if !fn(item.Id, item) {
+			break
+		}
+	}
+	return nil
+}
+
+// State returns the state of an item in the active queue at the given time.
+func (i *Item) State(now time.Time) queue.ItemState_State {
+	if i.ClaimExpiry.AsTime().After(now) {
+		return queue.ItemState_ITEM_STATE_DOWNLOADING
+	}
+	if i.Blocked() {
+		return queue.ItemState_ITEM_STATE_BLOCKED
+	}
+	if i.NotBefore.AsTime().After(now) {
+		return queue.ItemState_ITEM_STATE_WAITING
+	}
+	return queue.ItemState_ITEM_STATE_QUEUED
+}
+
+// ItemState returns the API state which corresponds to the finished item's state.
+func (i *FinishedItem) ItemState() queue.ItemState_State {
+	switch i.State {
+	case FinishedItem_ITEM_STATE_SUCCESS:
+		return queue.ItemState_ITEM_STATE_COMPLETE
+	case FinishedItem_ITEM_STATE_FAILED:
+		return queue.ItemState_ITEM_STATE_FAILED
+	case FinishedItem_ITEM_STATE_CANCELLED:
+		return queue.ItemState_ITEM_STATE_FAILED
+	default:
+		return queue.ItemState_ITEM_STATE_UNSPECIFIED
+	}
+}
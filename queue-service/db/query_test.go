@@ -0,0 +1,191 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"slices"
+	"testing"
+	"time"
+)
+
+// collect pages through a listing, returning the ids of every item in the order they were listed.
+func collect[T any](t *testing.T, list func(ItemQuery) ([]T, string, error), id func(T) string, query ItemQuery) []string {
+	t.Helper()
+	var out []string
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatalf("too many pages")
+		}
+		items, next, err := list(query)
+		if err != nil {
+			t.Fatalf("unexpected error listing items: %v", err)
+		}
+		if uint(len(items)) > query.Limit() {
+			t.Fatalf("expected at most %v items in the page, got %v", query.Limit(), len(items))
+		}
+		for _, item := range items {
+			out = append(out, id(item))
+		}
+		if next == "" {
+			return out
+		}
+		query.Cursor = next
+	}
+}
+
+func TestStore_GetQueueItems(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 0, 1, 0, 1, 0)
+			list := func(q ItemQuery) ([]*Item, string, error) { return s.GetQueueItems("q", q) }
+			id := func(item *Item) string { return item.Id }
+
+			want := []string{ids[1], ids[3], ids[0], ids[2], ids[4]}
+			if got := collect(t, list, id, ItemQuery{PageSize: 2}); !slices.Equal(got, want) {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+			slices.Reverse(want)
+			if got := collect(t, list, id, ItemQuery{PageSize: 2, Descending: true}); !slices.Equal(got, want) {
+				t.Errorf("descending: expected %v, got %v", want, got)
+			}
+
+			claimed, err := s.ClaimNextItem("q", "")
+			if err != nil {
+				t.Fatalf("unexpected error claiming item: %v", err)
+			}
+			filter := ItemFilter{States: []queue.ItemState_State{queue.ItemState_ITEM_STATE_DOWNLOADING}}
+			if got := collect(t, list, id, ItemQuery{PageSize: 2, Filter: filter}); !slices.Equal(got, []string{claimed.Id}) {
+				t.Errorf("expected only the claimed item, got %v", got)
+			}
+
+			// the item at the cursor being removed between pages shouldn't matter
+			first, next, err := s.GetQueueItems("q", ItemQuery{PageSize: 2})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := s.CancelItem(first[1].Id); err != nil {
+				t.Fatalf("unexpected error cancelling item: %v", err)
+			}
+			rest := collect(t, list, id, ItemQuery{PageSize: 2, Cursor: next})
+			if want := []string{ids[0], ids[2], ids[4]}; !slices.Equal(rest, want) {
+				t.Errorf("expected %v after the cursor, got %v", want, rest)
+			}
+
+			_, _, err = s.GetQueueItems("q", ItemQuery{PageSize: 2, Cursor: next, Sort: SortSource})
+			if !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected a cursor used with a different sort to be rejected, got %v", err)
+			}
+			_, _, err = s.GetQueueItems("q", ItemQuery{Cursor: "not a cursor"})
+			if !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected a malformed cursor to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestStore_GetFinishedItems(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			sources := []string{
+				"http://b.example.com/1",
+				"https://A.example.com:8443/2",
+				"http://b.example.com/3",
+				"http://a.example.com/4",
+				"http://c.example.com/5",
+			}
+			var ids []string
+			for i, src := range sources {
+				category := "odd"
+				if i%2 == 0 {
+					category = "even"
+				}
+				id, err := s.EnqueueItem("q", &Item{
+					Source:      &Target{Url: src},
+					Destination: &Target{Url: "file://example"},
+					Category:    &Category{Id: category},
+				})
+				if err != nil {
+					t.Fatalf("unable to enqueue item: %v", err)
+				}
+				ids = append(ids, id)
+				// finished item keys are ordered by time
+				time.Sleep(time.Millisecond)
+				if i == 1 || i == 2 {
+					_, err = s.SetItemState(id, 0, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_UNSPECIFIED, errors.New("failed"))
+				} else {
+					_, err = s.SetItemState(id, 0, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil)
+				}
+				if err != nil {
+					t.Fatalf("unable to finish item: %v", err)
+				}
+			}
+
+			list := func(q ItemQuery) ([]*FinishedItem, string, error) { return s.GetFinishedItems("q", q) }
+			id := func(item *FinishedItem) string { return item.Item.Id }
+
+			tests := []struct {
+				name  string
+				query ItemQuery
+				want  []string
+			}{
+				{
+					name:  "default order",
+					query: ItemQuery{PageSize: 2},
+					want:  ids,
+				},
+				{
+					name:  "descending",
+					query: ItemQuery{PageSize: 2, Descending: true},
+					want:  []string{ids[4], ids[3], ids[2], ids[1], ids[0]},
+				},
+				{
+					name:  "failed",
+					query: ItemQuery{PageSize: 1, Filter: ItemFilter{States: []queue.ItemState_State{queue.ItemState_ITEM_STATE_FAILED}}},
+					want:  []string{ids[1], ids[2]},
+				},
+				{
+					name:  "category and host",
+					query: ItemQuery{PageSize: 1, Filter: ItemFilter{Category: "odd", SourceHost: "a.example.com"}},
+					want:  []string{ids[1], ids[3]},
+				},
+				{
+					name:  "by source",
+					query: ItemQuery{PageSize: 2, Sort: SortSource},
+					want:  []string{ids[3], ids[0], ids[2], ids[4], ids[1]},
+				},
+				{
+					name:  "by source descending",
+					query: ItemQuery{PageSize: 3, Sort: SortSource, Descending: true},
+					want:  []string{ids[1], ids[4], ids[2], ids[0], ids[3]},
+				},
+				{
+					name:  "updated in the future",
+					query: ItemQuery{PageSize: 2, Filter: ItemFilter{UpdatedAfter: time.Now().Add(time.Hour)}},
+					want:  nil,
+				},
+			}
+
+			for _, tt := range tests {
+				if got := collect(t, list, id, tt.query); !slices.Equal(got, tt.want) {
+					t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestItemQuery_Limit(t *testing.T) {
+	tests := map[uint]uint{
+		0:               DefaultPageSize,
+		1:               1,
+		MaxPageSize:     MaxPageSize,
+		MaxPageSize + 1: MaxPageSize,
+	}
+	for pageSize, expected := range tests {
+		if got := (ItemQuery{PageSize: pageSize}).Limit(); got != expected {
+			t.Errorf("expected a page size of %v to give a limit of %v, got %v", pageSize, expected, got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"fmt"
+	"time"
+)
+
+// PruneHistory removes the items in the queue's history which its retention policy no longer
+// keeps as of now, and returns how many were removed.  A queue without a policy keeps its
+// whole history.
+func (s *store) PruneHistory(queueID string, now time.Time) (int, error) {
+	q := sanitiseQueueName(queueID)
+	var removed int
+	err := s.backend.update(func(tx txn) error {
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		policy := meta.HistoryRetention
+		if policy.unlimited() {
+			return nil
+		}
+		// items are visited newest first, so counted is the number of newer items which count
+		// towards the entry limit
+		counted := 0
+		removed, err = deleteFinishedWhere(tx, q, func(item *FinishedItem) bool {
+			keep := policy.keeps(counted, item, now)
+			if item.State != FinishedItem_ITEM_STATE_FAILED {
+				counted++
+			}
+			return !keep
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if removed > 0 {
+		prunedItems.WithLabelValues(q).Add(float64(removed))
+	}
+	return removed, nil
+}
+
+// unlimited reports whether the policy keeps every item.  Zero ages, like unset ones, don't
+// limit the history.  A failed item age on its own doesn't either, since failed items are never
+// removed before the others would be.
+func (r *HistoryRetention) unlimited() bool {
+	return r.GetMaxEntries() == 0 && r.GetMaxAge().AsDuration() <= 0
+}
+
+// keeps reports whether the policy keeps a finished item, where counted is the number of newer
+// items which count towards the entry limit.  Failed items are kept for at least as long as
+// other items: they don't count towards the entry limit and aren't removed by it, and are kept
+// for the longer of the two ages.
+func (r *HistoryRetention) keeps(counted int, item *FinishedItem, now time.Time) bool {
+	failed := item.State == FinishedItem_ITEM_STATE_FAILED
+	if !failed && r.GetMaxEntries() > 0 && counted >= int(r.MaxEntries) {
+		return false
+	}
+	maxAge := r.GetMaxAge().AsDuration()
+	if failed && maxAge > 0 {
+		maxAge = max(maxAge, r.GetFailedMaxAge().AsDuration())
+	}
+	return maxAge <= 0 || now.Sub(item.Timestamp.AsTime()) <= maxAge
+}
+
+// deleteFinishedWhere removes the items in the queue's history for which remove returns true,
+// and returns how many were removed.  remove is called with each item, newest first.
+func deleteFinishedWhere(tx txn, q string, remove func(item *FinishedItem) bool) (int, error) {
+	type entry struct{ key, id string }
+	var doomed []entry
+	err := tx.walkFinished(q, "", true, func(key string, item *FinishedItem) bool {
+		if remove(item) {
+			doomed = append(doomed, entry{key: key, id: item.GetItem().GetId()})
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	// the items are removed once the walk is over, as some backends can't delete while iterating
+	for _, e := range doomed {
+		if err := tx.deleteFinished(q, e.key, e.id); err != nil {
+			return 0, fmt.Errorf("error removing finished item %v: %w", e.id, err)
+		}
+	}
+	return len(doomed), nil
+}
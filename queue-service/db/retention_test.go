@@ -0,0 +1,148 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"testing"
+	"time"
+)
+
+// finishTestItems claims each of the queue's items in turn and finishes it in the given state.
+func finishTestItems(t *testing.T, s Store, states ...queue.ItemState_State) []string {
+	t.Helper()
+	ids := enqueueTestItems(t, s, make([]int32, len(states))...)
+	for i, state := range states {
+		claimed, err := s.ClaimNextItem("q", "w")
+		if err != nil || claimed.Id != ids[i] {
+			t.Fatalf("unable to claim item: %v, %v", claimed, err)
+		}
+		class, cause := FailureClass_FAILURE_CLASS_UNSPECIFIED, error(nil)
+		if state == queue.ItemState_ITEM_STATE_FAILED {
+			class, cause = FailureClass_FAILURE_CLASS_NOT_FOUND, errors.New("not found")
+		}
+		if _, err := s.SetItemState(ids[i], claimed.Lease, state, 0, 0, class, cause); err != nil {
+			t.Fatalf("unable to finish item: %v", err)
+		}
+	}
+	return ids
+}
+
+func historyIDs(t *testing.T, s Store) []string {
+	t.Helper()
+	finished, _, err := s.GetFinishedItems("q", ItemQuery{PageSize: MaxPageSize})
+	if err != nil {
+		t.Fatalf("unable to list history: %v", err)
+	}
+	var ids []string
+	for _, item := range finished {
+		ids = append(ids, item.Item.Id)
+	}
+	return ids
+}
+
+func TestStore_PruneHistory(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, &Queue{HistoryRetention: &HistoryRetention{
+				MaxEntries:   3,
+				MaxAge:       durationpb.New(time.Hour),
+				FailedMaxAge: durationpb.New(24 * time.Hour),
+			}})
+			ids := finishTestItems(t, s,
+				queue.ItemState_ITEM_STATE_FAILED,
+				queue.ItemState_ITEM_STATE_COMPLETE,
+				queue.ItemState_ITEM_STATE_FAILED,
+				queue.ItemState_ITEM_STATE_COMPLETE,
+				queue.ItemState_ITEM_STATE_COMPLETE,
+				queue.ItemState_ITEM_STATE_COMPLETE,
+			)
+			now := time.Now()
+
+			if removed, err := s.PruneHistory("q", now); err != nil || removed != 1 {
+				t.Errorf("expected the oldest completed item to be over the entry limit, got %v, %v", removed, err)
+			}
+			if got := historyIDs(t, s); len(got) != 5 || slices.Contains(got, ids[1]) {
+				t.Errorf("expected the failed items to be kept beyond the entry limit, got %v", got)
+			}
+			if removed, err := s.PruneHistory("q", now.Add(2*time.Hour)); err != nil || removed != 3 {
+				t.Errorf("expected the completed items to expire, got %v, %v", removed, err)
+			}
+			if got := historyIDs(t, s); len(got) != 2 || !slices.Contains(got, ids[0]) || !slices.Contains(got, ids[2]) {
+				t.Errorf("expected only the failed items to be kept, got %v", got)
+			}
+			if removed, err := s.PruneHistory("q", now.Add(25*time.Hour)); err != nil || removed != 2 {
+				t.Errorf("expected the failed items to expire, got %v, %v", removed, err)
+			}
+			if _, err := s.PruneHistory("missing", now); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected pruning a missing queue to fail, got %v", err)
+			}
+		})
+	}
+}
+
+func TestHistoryRetention_Keeps(t *testing.T) {
+	now := time.Now()
+	finished := func(state FinishedItem_State, age time.Duration) *FinishedItem {
+		return &FinishedItem{State: state, Timestamp: timestamppb.New(now.Add(-age))}
+	}
+	const (
+		complete = FinishedItem_ITEM_STATE_SUCCESS
+		failed   = FinishedItem_ITEM_STATE_FAILED
+	)
+	tests := []struct {
+		name    string
+		policy  *HistoryRetention
+		counted int
+		item    *FinishedItem
+		want    bool
+	}{
+		{"within the entry limit", &HistoryRetention{MaxEntries: 2}, 1, finished(complete, 0), true},
+		{"over the entry limit", &HistoryRetention{MaxEntries: 2}, 2, finished(complete, 0), false},
+		{"failed over the entry limit", &HistoryRetention{MaxEntries: 2}, 2, finished(failed, 100*time.Hour), true},
+		{"within the age", &HistoryRetention{MaxAge: durationpb.New(time.Hour)}, 0, finished(complete, time.Minute), true},
+		{"over the age", &HistoryRetention{MaxAge: durationpb.New(time.Hour)}, 0, finished(complete, 2*time.Hour), false},
+		{"failed over the age", &HistoryRetention{MaxAge: durationpb.New(time.Hour)}, 0, finished(failed, 2*time.Hour), false},
+		{"failed within the failed age", &HistoryRetention{MaxAge: durationpb.New(time.Hour), FailedMaxAge: durationpb.New(3 * time.Hour)}, 0, finished(failed, 2*time.Hour), true},
+		{"failed with a shorter failed age", &HistoryRetention{MaxAge: durationpb.New(time.Hour), FailedMaxAge: durationpb.New(time.Minute)}, 0, finished(failed, 30*time.Minute), true},
+		{"failed with only a failed age", &HistoryRetention{FailedMaxAge: durationpb.New(time.Hour)}, 0, finished(failed, 2*time.Hour), true},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.keeps(tt.counted, tt.item, now); got != tt.want {
+			t.Errorf("%v: expected keeps to be %v, got %v", tt.name, tt.want, got)
+		}
+	}
+	if !(&HistoryRetention{FailedMaxAge: durationpb.New(time.Hour)}).unlimited() {
+		t.Errorf("expected a policy with only a failed item age to keep everything")
+	}
+}
+
+func TestStore_ClearHistoryFilter(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := finishTestItems(t, s,
+				queue.ItemState_ITEM_STATE_FAILED,
+				queue.ItemState_ITEM_STATE_COMPLETE,
+				queue.ItemState_ITEM_STATE_FAILED,
+			)
+
+			removed, err := s.ClearHistory("q", &ItemFilter{UpdatedAfter: time.Now().Add(time.Hour)})
+			if err != nil || removed != 0 {
+				t.Errorf("expected no items to be newer than an hour from now, got %v, %v", removed, err)
+			}
+			removed, err = s.ClearHistory("q", &ItemFilter{States: []queue.ItemState_State{queue.ItemState_ITEM_STATE_FAILED}})
+			if err != nil || removed != 2 {
+				t.Errorf("expected the failed items to be removed, got %v, %v", removed, err)
+			}
+			if got := historyIDs(t, s); len(got) != 1 || got[0] != ids[1] {
+				t.Errorf("expected the completed item to be kept, got %v", got)
+			}
+			if removed, err := s.ClearHistory("q", nil); err != nil || removed != 1 {
+				t.Errorf("expected the rest of the history to be removed, got %v, %v", removed, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	DefaultInitialBackoff = time.Minute
+	DefaultMaxBackoff     = time.Hour
+	DefaultMultiplier     = 2.0
+)
+
+// defaultRetryOn lists the failure classes which are retried by a policy which doesn't name any.
+// These are the failures which are likely to be transient.
+var defaultRetryOn = []FailureClass{
+	FailureClass_FAILURE_CLASS_UNSPECIFIED,
+	FailureClass_FAILURE_CLASS_NETWORK,
+	FailureClass_FAILURE_CLASS_SERVER,
+}
+
+// retries reports whether an item which has failed the given number of times, most recently
+// with a failure of the given class, should be attempted again.
+func (p *RetryPolicy) retries(failures int, class FailureClass) bool {
+	if failures >= int(p.GetMaxAttempts()) {
+		return false
+	}
+	retryOn := p.GetRetryOn()
+	if len(retryOn) == 0 {
+		retryOn = defaultRetryOn
+	}
+	return slices.Contains(retryOn, class)
+}
+
+// backoff returns the time to wait before the next attempt of an item which has failed the given
+// number of times.
+func (p *RetryPolicy) backoff(failures int) time.Duration {
+	initial := defaultIfEmpty(DefaultInitialBackoff, p.GetInitialBackoff().AsDuration())
+	limit := defaultIfEmpty(DefaultMaxBackoff, p.GetMaxBackoff().AsDuration())
+	multiplier := p.GetMultiplier()
+	if multiplier < 1 {
+		multiplier = DefaultMultiplier
+	}
+
+	backoff := float64(initial) * math.Pow(multiplier, float64(failures-1))
+	if backoff >= float64(limit) {
+		return limit
+	}
+	return time.Duration(backoff)
+}
+
+// SetQueueRetryPolicy sets the retry policy applied to items in the queue which don't have their
+// own.  A nil policy disables retries.
+func (s *store) SetQueueRetryPolicy(queue string, policy *RetryPolicy) error {
+	_, err := s.UpdateQueue(queue, func(meta *Queue) error {
+		meta.RetryPolicy = policy
+		return nil
+	})
+	return err
+}
+
+// retryPolicy returns the retry policy which applies to the item: its own, then its category's,
+// then its queue's.
+func retryPolicy(tx txn, q string, item *Item) (*RetryPolicy, error) {
+	if item.RetryPolicy != nil {
+		return item.RetryPolicy, nil
+	}
+	if id := item.GetCategory().GetId(); id != "" {
+		category, err := tx.getCategory(id)
+		if err == nil && category.RetryPolicy != nil {
+			return category.RetryPolicy, nil
+		}
+		if err != nil && !errors.As(err, &ErrNotFound{}) {
+			return nil, err
+		}
+	}
+	meta, err := tx.getQueueMeta(q)
+	if err != nil {
+		return nil, err
+	}
+	return meta.RetryPolicy, nil
+}
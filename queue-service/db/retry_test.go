@@ -0,0 +1,122 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: durationpb.New(time.Second),
+		MaxBackoff:     durationpb.New(10 * time.Second),
+		Multiplier:     3,
+	}
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 3 * time.Second},
+		{3, 9 * time.Second},
+		{4, 10 * time.Second},
+		{9, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.backoff(tt.failures); got != tt.want {
+			t.Errorf("after %v failures: expected %v, got %v", tt.failures, tt.want, got)
+		}
+	}
+
+	var empty *RetryPolicy
+	if got := empty.backoff(2); got != 2*DefaultInitialBackoff {
+		t.Errorf("expected defaults to apply to an empty policy, got %v", got)
+	}
+}
+
+func TestRetryPolicy_Retries(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3}
+	if !policy.retries(2, FailureClass_FAILURE_CLASS_NETWORK) {
+		t.Errorf("expected a network failure to be retried")
+	}
+	if policy.retries(3, FailureClass_FAILURE_CLASS_NETWORK) {
+		t.Errorf("expected no retry once attempts are exhausted")
+	}
+	if policy.retries(1, FailureClass_FAILURE_CLASS_NOT_FOUND) {
+		t.Errorf("expected not found to be excluded by default")
+	}
+
+	policy.RetryOn = []FailureClass{FailureClass_FAILURE_CLASS_NOT_FOUND}
+	if !policy.retries(1, FailureClass_FAILURE_CLASS_NOT_FOUND) || policy.retries(1, FailureClass_FAILURE_CLASS_NETWORK) {
+		t.Errorf("expected retry_on to replace the default classes")
+	}
+
+	var none *RetryPolicy
+	if none.retries(1, FailureClass_FAILURE_CLASS_NETWORK) {
+		t.Errorf("expected no retries without a policy")
+	}
+}
+
+func TestStore_FailItemRetries(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			err := s.SetQueueRetryPolicy("q", &RetryPolicy{MaxAttempts: 2, InitialBackoff: durationpb.New(time.Hour)})
+			if err != nil {
+				t.Fatalf("unable to set retry policy: %v", err)
+			}
+			ids := enqueueTestItems(t, s, 0, 0)
+
+			claimed, err := s.ClaimNextItem("q", "")
+			if err != nil || claimed.Id != ids[0] {
+				t.Fatalf("expected to claim %v, got %v, %v", ids[0], claimed, err)
+			}
+			ch, err := s.SetItemState(ids[0], 0, queue.ItemState_ITEM_STATE_FAILED, 5, 10, FailureClass_FAILURE_CLASS_NETWORK, errors.New("connection reset"))
+			if err != nil {
+				t.Fatalf("unexpected error failing item: %v", err)
+			}
+			if len(ch.Updated) != 1 || ch.Updated[0].Id != ids[0] {
+				t.Fatalf("expected the item to be rescheduled, got %v", ch.Updated)
+			}
+			retry := ch.Updated[0]
+			if state := retry.State(time.Now()); state != queue.ItemState_ITEM_STATE_WAITING {
+				t.Errorf("expected the item to be waiting, got %v", state)
+			}
+			if len(retry.Attempts) != 1 || retry.Attempts[0].Message != "connection reset" {
+				t.Errorf("expected the attempt to be recorded, got %v", retry.Attempts)
+			}
+
+			// the waiting item is skipped
+			claimed, err = s.ClaimNextItem("q", "")
+			if err != nil || claimed.Id != ids[1] {
+				t.Fatalf("expected to claim %v, got %v, %v", ids[1], claimed, err)
+			}
+			claimed, err = s.ClaimNextItem("q", "")
+			if err != nil || claimed != nil {
+				t.Fatalf("expected nothing to claim, got %v, %v", claimed, err)
+			}
+
+			// a failure which isn't retried goes straight to the history
+			ch, err = s.SetItemState(ids[1], 0, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NOT_FOUND, errors.New("not found"))
+			if err != nil || len(ch.Updated) != 0 {
+				t.Fatalf("expected the item to finish, got %v, %v", ch, err)
+			}
+
+			// as does the final attempt
+			ch, err = s.SetItemState(ids[0], 0, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NETWORK, errors.New("timeout"))
+			if err != nil || len(ch.Updated) != 0 {
+				t.Fatalf("expected the item to finish, got %v, %v", ch, err)
+			}
+			finished, _, err := s.GetFinishedItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil {
+				t.Fatalf("unable to list finished items: %v", err)
+			}
+			if len(finished) != 2 || len(finished[1].Item.Attempts) != 2 {
+				t.Errorf("expected both items in the history, the last with two attempts, got %v", finished)
+			}
+		})
+	}
+}
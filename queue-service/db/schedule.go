@@ -0,0 +1,38 @@
+package db
+
+import (
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"strings"
+	"time"
+)
+
+var recurrenceParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseRecurrence parses the cron expression of a recurring item.  Expressions are evaluated in
+// UTC unless they name a time zone with a CRON_TZ= prefix.
+func ParseRecurrence(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "CRON_TZ=") && !strings.HasPrefix(expr, "TZ=") {
+		expr = "CRON_TZ=UTC " + expr
+	}
+	sched, err := recurrenceParser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence: %w", err)
+	}
+	return sched, nil
+}
+
+// nextRun returns the time after now at which the recurring item should next be downloaded.
+func nextRun(recurrence string, now time.Time) (time.Time, error) {
+	sched, err := ParseRecurrence(recurrence)
+	if err != nil {
+		return time.Time{}, ErrInvalid{}
+	}
+	next := sched.Next(now)
+	if next.IsZero() {
+		// the expression can never be satisfied, such as the 30th of February
+		return time.Time{}, ErrInvalid{}
+	}
+	return next, nil
+}
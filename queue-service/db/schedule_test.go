@@ -0,0 +1,89 @@
+package db
+
+import (
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"testing"
+	"time"
+)
+
+func TestNextRun(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		expr    string
+		want    time.Time
+		invalid bool
+	}{
+		{expr: "0 2 * * *", want: time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC)},
+		{expr: "@hourly", want: time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC)},
+		{expr: "CRON_TZ=America/New_York 0 9 * * *", want: time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC)},
+		{expr: "0 0 30 2 *", invalid: true},
+		{expr: "every day", invalid: true},
+	}
+	for _, tt := range tests {
+		got, err := nextRun(tt.expr, now)
+		if tt.invalid {
+			if !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("%q: expected the expression to be invalid, got %v, %v", tt.expr, got, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.expr, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%q: expected %v, got %v", tt.expr, tt.want, got.UTC())
+		}
+	}
+}
+
+func TestStore_Recurrence(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			id, err := s.EnqueueItem("q", &Item{
+				Source:      &Target{Url: "http://example.com/nightly.tar.gz"},
+				Destination: &Target{Url: "file://nightly.tar.gz"},
+				Category:    &Category{Id: "default"},
+				Recurrence:  "@daily",
+			})
+			if err != nil {
+				t.Fatalf("unable to enqueue item: %v", err)
+			}
+
+			items, _, err := s.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil || len(items) != 1 {
+				t.Fatalf("expected one item, got %v, %v", items, err)
+			}
+			if state := items[0].State(time.Now()); state != queue.ItemState_ITEM_STATE_WAITING {
+				t.Errorf("expected the item to wait for its first run, got %v", state)
+			}
+			if claimed, err := s.ClaimNextItem("q", ""); err != nil || claimed != nil {
+				t.Fatalf("expected nothing to claim before the first run, got %v, %v", claimed, err)
+			}
+
+			ch, err := s.SetItemState(id, 0, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil)
+			if err != nil {
+				t.Fatalf("unexpected error completing item: %v", err)
+			}
+			if len(ch.Enqueued) != 1 {
+				t.Fatalf("expected the next run to be enqueued, got %v", ch.Enqueued)
+			}
+			next := ch.Enqueued[0]
+			if next.Id == id || next.PreviousRun != id || next.Recurrence != "@daily" {
+				t.Fatalf("expected the next run to be enqueued, linked to %v, got %v", id, next)
+			}
+			if !next.NotBefore.AsTime().After(time.Now()) {
+				t.Errorf("expected the next run to be in the future, got %v", next.NotBefore.AsTime())
+			}
+
+			if _, err := s.CancelItem(next.Id); err != nil {
+				t.Fatalf("unexpected error cancelling item: %v", err)
+			}
+			if got := queueOrder(t, s); len(got) != 0 {
+				t.Errorf("expected cancelling to stop the recurrence, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,541 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"io"
+	_ "modernc.org/sqlite"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// sqliteSchema creates the store's tables.  Records are held as marshalled protobufs, as they
+// are by the other backends, but the history also keeps its main fields in columns so that it
+// can be reported on with SQL.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS queues (
+	key       TEXT PRIMARY KEY,
+	name      TEXT NOT NULL,
+	meta      BLOB NOT NULL,
+	item_seq  INTEGER NOT NULL,
+	lease_seq INTEGER NOT NULL DEFAULT 0
+);
+CREATE TABLE IF NOT EXISTS items (
+	queue TEXT NOT NULL REFERENCES queues (key),
+	id    TEXT NOT NULL,
+	data  BLOB NOT NULL,
+	PRIMARY KEY (queue, id)
+);
+CREATE TABLE IF NOT EXISTS finished (
+	queue            TEXT NOT NULL REFERENCES queues (key),
+	key              TEXT NOT NULL,
+	id               TEXT NOT NULL,
+	state            TEXT NOT NULL,
+	source           TEXT NOT NULL,
+	destination      TEXT NOT NULL,
+	category         TEXT NOT NULL,
+	total_size_bytes INTEGER NOT NULL,
+	downloaded_bytes INTEGER NOT NULL,
+	finished_at      TEXT NOT NULL,
+	message          TEXT NOT NULL,
+	attempts         INTEGER NOT NULL,
+	data             BLOB NOT NULL,
+	PRIMARY KEY (queue, key)
+);
+CREATE INDEX IF NOT EXISTS finished_id ON finished (queue, id);
+CREATE TABLE IF NOT EXISTS api_keys (
+	name TEXT PRIMARY KEY,
+	role TEXT NOT NULL,
+	data BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS categories (
+	name TEXT PRIMARY KEY,
+	data BLOB NOT NULL
+);
+`
+
+func NewSQLite(path string) (*SQLite, error) {
+	dsn := "file:" + path + "?" + url.Values{
+		"_pragma": {"busy_timeout(1000)", "journal_mode(WAL)", "foreign_keys(1)"},
+	}.Encode()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// sqlite allows a single writer, and transactions are short, so serialise them here rather
+	// than having them wait on the database's lock
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating schema: %w", err)
+	}
+	out := &SQLite{
+		db: db,
+	}
+	out.store.backend = out
+	if err := out.initSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return out, nil
+}
+
+// SQLite is a store held in a SQLite database.
+type SQLite struct {
+	store
+	db *sql.DB
+}
+
+func (s *SQLite) Close() error {
+	return s.db.Close()
+}
+
+// snapshot copies the database into a temporary file, which is consistent as it is written in a
+// single statement, and then writes out the file.
+func (s *SQLite) snapshot(w io.Writer) error {
+	dir, err := os.MkdirTemp("", "godm-snapshot-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "snapshot.db")
+	if _, err := s.db.Exec(`VACUUM INTO ?`, path); err != nil {
+		return fmt.Errorf("error copying database: %w", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func (s *SQLite) view(fn func(tx txn) error) error {
+	defer observeTransaction(BackendSQLite, transactionView, time.Now())
+	return s.run(&sql.TxOptions{ReadOnly: true}, fn)
+}
+
+func (s *SQLite) update(fn func(tx txn) error) error {
+	defer observeTransaction(BackendSQLite, transactionUpdate, time.Now())
+	return s.run(nil, fn)
+}
+
+func (s *SQLite) run(opts *sql.TxOptions, fn func(tx txn) error) error {
+	tx, err := s.db.BeginTx(context.Background(), opts)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	if err := fn(sqliteTxn{tx}); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+type sqliteTxn struct {
+	tx *sql.Tx
+}
+
+// schemaVersion is held in the database header's user version, which is 0 in a new database.
+func (t sqliteTxn) schemaVersion() (int, error) {
+	var out int
+	err := t.tx.QueryRow(`PRAGMA user_version`).Scan(&out)
+	return out, err
+}
+
+func (t sqliteTxn) setSchemaVersion(version int) error {
+	// pragmas don't take parameters
+	_, err := t.tx.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, version))
+	return err
+}
+
+func (t sqliteTxn) createQueue(meta *Queue) error {
+	if err := t.exists(meta.Id); err == nil {
+		return ErrConflict{}
+	}
+	bs, err := proto.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue object: %w", err)
+	}
+	_, err = t.tx.Exec(`INSERT INTO queues (key, name, meta, item_seq) VALUES (?, ?, ?, ?)`, meta.Id, meta.Name, bs, firstSequence)
+	if err != nil {
+		return fmt.Errorf("error creating queue %v: %w", meta.Id, err)
+	}
+	return nil
+}
+
+func (t sqliteTxn) queueKeys() ([]string, error) {
+	rows, err := t.tx.Query(`SELECT key FROM queues ORDER BY key`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		out = append(out, key)
+	}
+	return out, rows.Err()
+}
+
+func (t sqliteTxn) deleteQueue(q string) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	for _, table := range []string{"items", "finished"} {
+		if _, err := t.tx.Exec(`DELETE FROM `+table+` WHERE queue = ?`, q); err != nil {
+			return fmt.Errorf("error deleting queue %v's %v: %w", q, table, err)
+		}
+	}
+	res, err := t.tx.Exec(`DELETE FROM queues WHERE key = ?`, q)
+	return checkAffected(res, err)
+}
+
+// exists returns ErrNotFound if the queue doesn't exist.
+func (t sqliteTxn) exists(q string) error {
+	var one int
+	err := t.tx.QueryRow(`SELECT 1 FROM queues WHERE key = ?`, q).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound{}
+	}
+	return err
+}
+
+func (t sqliteTxn) getQueueMeta(q string) (*Queue, error) {
+	var bs []byte
+	err := t.tx.QueryRow(`SELECT meta FROM queues WHERE key = ?`, q).Scan(&bs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out Queue
+	if err := proto.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling queue object: %w", err)
+	}
+	return &out, nil
+}
+
+func (t sqliteTxn) putQueueMeta(meta *Queue) error {
+	bs, err := proto.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("error marshalling queue object: %w", err)
+	}
+	res, err := t.tx.Exec(`UPDATE queues SET name = ?, meta = ? WHERE key = ?`, meta.Name, bs, meta.Id)
+	return checkAffected(res, err)
+}
+
+func (t sqliteTxn) nextSequence(q string) (uint64, error) {
+	return t.increment(q, "item_seq")
+}
+
+func (t sqliteTxn) nextLease(q string) (uint64, error) {
+	return t.increment(q, "lease_seq")
+}
+
+// increment adds one to the named sequence column of the queue, and returns its new value.
+func (t sqliteTxn) increment(q string, column string) (uint64, error) {
+	var out uint64
+	err := t.tx.QueryRow(`UPDATE queues SET `+column+` = `+column+` + 1 WHERE key = ? RETURNING `+column, q).Scan(&out)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrNotFound{}
+	}
+	return out, err
+}
+
+func (t sqliteTxn) sequences(q string) (uint64, uint64, error) {
+	var item, lease uint64
+	err := t.tx.QueryRow(`SELECT item_seq, lease_seq FROM queues WHERE key = ?`, q).Scan(&item, &lease)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, ErrNotFound{}
+	}
+	return item, lease, err
+}
+
+func (t sqliteTxn) setSequences(q string, item uint64, lease uint64) error {
+	res, err := t.tx.Exec(`UPDATE queues SET item_seq = ?, lease_seq = ? WHERE key = ?`, int64(item), int64(lease), q)
+	return checkAffected(res, err)
+}
+
+func (t sqliteTxn) getItem(q string, id string) (*Item, error) {
+	var bs []byte
+	err := t.tx.QueryRow(`SELECT data FROM items WHERE queue = ? AND id = ?`, q, id).Scan(&bs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &Item{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling item: %w", err)
+	}
+	return out, nil
+}
+
+func (t sqliteTxn) items(q string) ([]*Item, error) {
+	if err := t.exists(q); err != nil {
+		return nil, err
+	}
+	rows, err := t.tx.Query(`SELECT id, data FROM items WHERE queue = ?`, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*Item
+	for rows.Next() {
+		var id string
+		var bs []byte
+		if err := rows.Scan(&id, &bs); err != nil {
+			return nil, err
+		}
+		item := &Item{}
+		if err := proto.Unmarshal(bs, item); err != nil {
+			return nil, fmt.Errorf("error unmarshalling item %v: %w", id, err)
+		}
+		out = append(out, item)
+	}
+	return out, rows.Err()
+}
+
+func (t sqliteTxn) putItem(q string, item *Item) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("error marshalling item: %w", err)
+	}
+	_, err = t.tx.Exec(`INSERT INTO items (queue, id, data) VALUES (?, ?, ?) ON CONFLICT (queue, id) DO UPDATE SET data = excluded.data`, q, item.Id, bs)
+	if err != nil {
+		return fmt.Errorf("error storing item: %w", err)
+	}
+	return nil
+}
+
+func (t sqliteTxn) deleteItem(q string, id string) error {
+	_, err := t.tx.Exec(`DELETE FROM items WHERE queue = ? AND id = ?`, q, id)
+	return err
+}
+
+func (t sqliteTxn) putFinished(q string, key string, item *FinishedItem) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	bs, err := proto.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("unable to marshal finished item: %w", err)
+	}
+	_, err = t.tx.Exec(`INSERT OR REPLACE INTO finished (queue, key, id, state, source, destination, category, total_size_bytes, downloaded_bytes, finished_at, message, attempts, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		q, key, item.GetItem().GetId(), item.State.String(),
+		item.GetItem().GetSource().GetUrl(), item.GetItem().GetDestination().GetUrl(), item.GetItem().GetCategory().GetId(),
+		int64(item.TotalSizeBytes), int64(item.DownloadedBytes), item.Timestamp.AsTime().UTC().Format(time.RFC3339Nano),
+		item.Message, len(item.GetItem().GetAttempts()), bs)
+	return err
+}
+
+func (t sqliteTxn) getFinished(q string, id string) (*FinishedItem, error) {
+	var bs []byte
+	err := t.tx.QueryRow(`SELECT data FROM finished WHERE queue = ? AND id = ?`, q, id).Scan(&bs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out FinishedItem
+	if err := proto.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling finished item: %w", err)
+	}
+	return &out, nil
+}
+
+func (t sqliteTxn) walkFinished(q string, after string, descending bool, fn func(key string, item *FinishedItem) bool) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	query := `SELECT key, data FROM finished WHERE queue = ? AND key > ? ORDER BY key`
+	args := []any{q, after}
+	switch {
+	case descending && after == "":
+		query = `SELECT key, data FROM finished WHERE queue = ? ORDER BY key DESC`
+		args = args[:1]
+	case descending:
+		query = `SELECT key, data FROM finished WHERE queue = ? AND key < ? ORDER BY key DESC`
+	}
+	rows, err := t.tx.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		var bs []byte
+		if err := rows.Scan(&key, &bs); err != nil {
+			return err
+		}
+		item := &FinishedItem{}
+		if err := proto.Unmarshal(bs, item); err != nil {
+			return fmt.Errorf("unmarshalling item: %w", err)
+		}
+		if !fn(key, item) {
+			break
+		}
+	}
+	return rows.Err()
+}
+
+func (t sqliteTxn) deleteFinished(q string, key string, id string) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	_, err := t.tx.Exec(`DELETE FROM finished WHERE queue = ? AND key = ?`, q, key)
+	return err
+}
+
+func (t sqliteTxn) clearFinished(q string) error {
+	if err := t.exists(q); err != nil {
+		return err
+	}
+	_, err := t.tx.Exec(`DELETE FROM finished WHERE queue = ?`, q)
+	return err
+}
+
+func (t sqliteTxn) finishedCount(q string) (int, error) {
+	if err := t.exists(q); err != nil {
+		return 0, err
+	}
+	var out int
+	err := t.tx.QueryRow(`SELECT COUNT(*) FROM finished WHERE queue = ?`, q).Scan(&out)
+	return out, err
+}
+
+func (t sqliteTxn) getKey(name string) (*ApiKey, error) {
+	var bs []byte
+	err := t.tx.QueryRow(`SELECT data FROM api_keys WHERE name = ?`, name).Scan(&bs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &ApiKey{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling key: %w", err)
+	}
+	return out, nil
+}
+
+func (t sqliteTxn) keys() ([]*ApiKey, error) {
+	rows, err := t.tx.Query(`SELECT name, data FROM api_keys ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*ApiKey
+	for rows.Next() {
+		var name string
+		var bs []byte
+		if err := rows.Scan(&name, &bs); err != nil {
+			return nil, err
+		}
+		key := &ApiKey{}
+		if err := proto.Unmarshal(bs, key); err != nil {
+			return nil, fmt.Errorf("error unmarshalling key %v: %w", name, err)
+		}
+		out = append(out, key)
+	}
+	return out, rows.Err()
+}
+
+func (t sqliteTxn) putKey(key *ApiKey) error {
+	bs, err := proto.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("error marshalling key: %w", err)
+	}
+	_, err = t.tx.Exec(`INSERT OR REPLACE INTO api_keys (name, role, data) VALUES (?, ?, ?)`, key.Name, key.Role.String(), bs)
+	return err
+}
+
+func (t sqliteTxn) deleteKey(name string) error {
+	_, err := t.tx.Exec(`DELETE FROM api_keys WHERE name = ?`, name)
+	return err
+}
+
+func (t sqliteTxn) getCategory(name string) (*CategoryConfig, error) {
+	var bs []byte
+	err := t.tx.QueryRow(`SELECT data FROM categories WHERE name = ?`, name).Scan(&bs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &CategoryConfig{}
+	if err := proto.Unmarshal(bs, out); err != nil {
+		return nil, fmt.Errorf("error unmarshalling category: %w", err)
+	}
+	return out, nil
+}
+
+func (t sqliteTxn) categories() ([]*CategoryConfig, error) {
+	rows, err := t.tx.Query(`SELECT name, data FROM categories ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []*CategoryConfig
+	for rows.Next() {
+		var name string
+		var bs []byte
+		if err := rows.Scan(&name, &bs); err != nil {
+			return nil, err
+		}
+		category := &CategoryConfig{}
+		if err := proto.Unmarshal(bs, category); err != nil {
+			return nil, fmt.Errorf("error unmarshalling category %v: %w", name, err)
+		}
+		out = append(out, category)
+	}
+	return out, rows.Err()
+}
+
+func (t sqliteTxn) putCategory(category *CategoryConfig) error {
+	bs, err := proto.Marshal(category)
+	if err != nil {
+		return fmt.Errorf("error marshalling category: %w", err)
+	}
+	_, err = t.tx.Exec(`INSERT OR REPLACE INTO categories (name, data) VALUES (?, ?)`, category.Id, bs)
+	return err
+}
+
+func (t sqliteTxn) deleteCategory(name string) error {
+	_, err := t.tx.Exec(`DELETE FROM categories WHERE name = ?`, name)
+	return err
+}
+
+// checkAffected returns ErrNotFound if a statement which succeeded changed no rows.
+func checkAffected(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound{}
+	}
+	return nil
+}
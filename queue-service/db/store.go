@@ -0,0 +1,1063 @@
+package db
+
+import (
+	"cmp"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/harryrose/godm/queue-service/queue"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	idSeparator     = ":"
+	MaxPageSize     = 100
+	DefaultPageSize = 50
+	// firstSequence is the sequence number after which a new queue's item ids start.  The bolt
+	// store has always started new queues' sequences at 10, so the constant only names that
+	// number; the other backends start at it too, so that a queue's item ids are the same
+	// whichever backend holds it.
+	firstSequence = 10
+)
+
+const (
+	BackendBolt   = "bolt"
+	BackendMemory = "memory"
+	BackendSQLite = "sqlite"
+)
+
+// Backends lists the names of the storage backends which Open accepts.
+var Backends = []string{BackendBolt, BackendMemory, BackendSQLite}
+
+// Store holds queues, their items and their history.
+type Store interface {
+	CreateQueue(name string, settings *Queue) (*Queue, error)
+	ListQueues() ([]*Queue, error)
+	RenameQueue(queue string, name string) (*Queue, error)
+	DeleteQueue(queue string, archive io.Writer) error
+	GetQueue(queue string) (*Queue, error)
+	UpdateQueue(queue string, update func(meta *Queue) error) (*Queue, error)
+	SetQueueRetryPolicy(queue string, policy *RetryPolicy) error
+
+	EnqueueItem(queue string, item *Item) (string, error)
+	EnqueueUniqueItem(queue string, item *Item, check DuplicateCheck) (string, error)
+	ClaimNextItem(queue string, worker string) (*Claim, error)
+	SetItemState(id string, lease uint64, state queue.ItemState_State, bytesDownloaded uint64, totalSizeBytes uint64, class FailureClass, err error) (*Changes, error)
+	CancelItem(id string) (*Changes, error)
+	MoveItem(id string, relativeTo string, after bool) (*Item, error)
+	MoveToFront(id string) (*Item, error)
+	MoveToBack(id string) (*Item, error)
+	UpdateItem(id string, update ItemUpdate) (*Item, error)
+
+	GetQueueItems(queueID string, query ItemQuery) ([]*Item, string, error)
+	GetFinishedItems(queueID string, query ItemQuery) ([]*FinishedItem, string, error)
+	ClearHistory(queueID string, filter *ItemFilter) (int, error)
+	PruneHistory(queueID string, now time.Time) (int, error)
+	RequeueItems(queueID string, ids []string, filter *ItemFilter) ([]*Item, error)
+	QueueStats(queueID string) (*QueueStats, error)
+
+	ListKeys() ([]*ApiKey, error)
+	CreateKey(key *ApiKey) error
+	UpdateKey(name string, update func(key *ApiKey) error) (*ApiKey, error)
+	DeleteKey(name string) error
+
+	ListCategories() ([]*CategoryConfig, error)
+	GetCategory(name string) (*CategoryConfig, error)
+	CreateCategory(category *CategoryConfig) error
+	UpdateCategory(name string, update func(category *CategoryConfig) error) (*CategoryConfig, error)
+	DeleteCategory(name string) error
+
+	SchemaVersion() (int, error)
+	Migrate(opts MigrateOptions) ([]Migration, error)
+	Snapshot(w io.Writer) error
+	Export(w io.Writer) error
+	Import(r io.Reader) (*ImportSummary, error)
+	Close() error
+}
+
+// Open opens the store of the named backend.  path is the file holding the store, and is
+// ignored by the memory backend.
+func Open(backend string, path string) (Store, error) {
+	switch backend {
+	case BackendBolt:
+		return NewBolt(path)
+	case BackendMemory:
+		return NewMemory(), nil
+	case BackendSQLite:
+		return NewSQLite(path)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q, expected one of %s", backend, strings.Join(Backends, ", "))
+	}
+}
+
+// backend runs transactions against the storage underlying a store.  A transaction whose
+// function returns an error leaves the storage unchanged.
+type backend interface {
+	view(fn func(tx txn) error) error
+	update(fn func(tx txn) error) error
+	// snapshot writes a consistent copy of the storage, in the backend's own file format.
+	snapshot(w io.Writer) error
+}
+
+// txn is a transaction against a store's storage.  Queues are identified by their keys, as
+// returned by QueueID.  Methods which are given a queue or item that doesn't exist return
+// ErrNotFound.
+type txn interface {
+	// schemaVersion returns the version recorded by setSchemaVersion, or 0 if none has been.
+	schemaVersion() (int, error)
+	setSchemaVersion(version int) error
+
+	// createQueue stores a new queue, whose key is meta.Id.  It returns ErrConflict if the queue
+	// exists.
+	createQueue(meta *Queue) error
+	// queueKeys returns the keys of the queues, in order.
+	queueKeys() ([]string, error)
+	// deleteQueue removes the queue, with its items and history.
+	deleteQueue(q string) error
+	getQueueMeta(q string) (*Queue, error)
+	putQueueMeta(meta *Queue) error
+	// nextSequence returns the next number from which to build an item id in the queue.
+	nextSequence(q string) (uint64, error)
+	// nextLease returns the next lease token to issue with a claim on one of the queue's items.
+	nextLease(q string) (uint64, error)
+	// sequences returns the last values returned by nextSequence and nextLease.
+	sequences(q string) (item uint64, lease uint64, err error)
+	setSequences(q string, item uint64, lease uint64) error
+
+	getItem(q string, id string) (*Item, error)
+	// items returns the queue's active items, in no particular order.
+	items(q string) ([]*Item, error)
+	putItem(q string, item *Item) error
+	deleteItem(q string, id string) error
+
+	// putFinished adds an item to the queue's history under the given key.  Keys order the
+	// history by the time the items finished.
+	putFinished(q string, key string, item *FinishedItem) error
+	// getFinished returns the item in the queue's history with the given item id.
+	getFinished(q string, id string) (*FinishedItem, error)
+	// walkFinished calls fn with the items in the queue's history in key order, beginning after
+	// the given key, or at the start if it is empty, until fn returns false.
+	walkFinished(q string, after string, descending bool, fn func(key string, item *FinishedItem) bool) error
+	// deleteFinished removes the item with the given id, held under the given key, from the
+	// queue's history.
+	deleteFinished(q string, key string, id string) error
+	clearFinished(q string) error
+	// finishedCount returns the number of items in the queue's history.
+	finishedCount(q string) (int, error)
+
+	getKey(name string) (*ApiKey, error)
+	// keys returns the API keys, ordered by name.
+	keys() ([]*ApiKey, error)
+	putKey(key *ApiKey) error
+	deleteKey(name string) error
+
+	getCategory(name string) (*CategoryConfig, error)
+	// categories returns the categories' settings, ordered by name.
+	categories() ([]*CategoryConfig, error)
+	putCategory(category *CategoryConfig) error
+	deleteCategory(name string) error
+}
+
+// store implements the queue operations on top of a backend, so that every backend behaves
+// the same way.
+type store struct {
+	backend backend
+}
+
+// CreateQueue creates a queue with the given name, whose id is derived from the name.  settings
+// holds the queue's initial configuration, and may be nil; its id, name and timestamp are
+// ignored.  It returns ErrConflict if a queue has the id or the name.
+func (s *store) CreateQueue(name string, settings *Queue) (*Queue, error) {
+	out := &Queue{}
+	if settings != nil {
+		out = proto.Clone(settings).(*Queue)
+	}
+	out.Id = sanitiseQueueName(name)
+	out.Name = name
+	out.Timestamp = &timestamp.Timestamp{
+		Seconds: time.Now().Unix(),
+	}
+	err := s.backend.update(func(tx txn) error {
+		if err := checkQueueName(tx, "", name); err != nil {
+			return err
+		}
+		return tx.createQueue(out)
+	})
+	return out, err
+}
+
+// ListQueues returns the records of every queue, ordered by id.
+func (s *store) ListQueues() ([]*Queue, error) {
+	var out []*Queue
+	err := s.backend.view(func(tx txn) error {
+		queues, err := tx.queueKeys()
+		if err != nil {
+			return err
+		}
+		for _, q := range queues {
+			meta, err := tx.getQueueMeta(q)
+			if err != nil {
+				return fmt.Errorf("queue %v: %w", q, err)
+			}
+			out = append(out, meta)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// RenameQueue changes the queue's name, and returns its updated record.  The queue's id is
+// unchanged.  It returns ErrConflict if another queue has the name.
+func (s *store) RenameQueue(queue string, name string) (*Queue, error) {
+	var out *Queue
+	q := sanitiseQueueName(queue)
+	err := s.backend.update(func(tx txn) error {
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		if err := checkQueueName(tx, q, name); err != nil {
+			return err
+		}
+		meta.Name = name
+		out = meta
+		return tx.putQueueMeta(meta)
+	})
+	return out, err
+}
+
+// checkQueueName returns ErrConflict if a queue other than q has the given name.
+func checkQueueName(tx txn, q string, name string) error {
+	queues, err := tx.queueKeys()
+	if err != nil {
+		return err
+	}
+	for _, other := range queues {
+		if other == q {
+			continue
+		}
+		meta, err := tx.getQueueMeta(other)
+		if err != nil {
+			return fmt.Errorf("queue %v: %w", other, err)
+		}
+		if meta.Name == name {
+			return ErrConflict{}
+		}
+	}
+	return nil
+}
+
+// DeleteQueue removes the queue, with its active items and history.  If archive isn't nil, the
+// queue is first written to it as an export, which Import can restore the queue from; the queue
+// is only deleted if the whole archive is written.
+func (s *store) DeleteQueue(queue string, archive io.Writer) error {
+	q := sanitiseQueueName(queue)
+	return s.backend.update(func(tx txn) error {
+		if archive != nil {
+			if err := writeQueueExport(tx, archive, q); err != nil {
+				return fmt.Errorf("error archiving queue: %w", err)
+			}
+		}
+		return tx.deleteQueue(q)
+	})
+}
+
+// EnqueueItem adds an item to the back of the given queue, among items of the same priority.
+// The item's id and position are assigned by EnqueueItem; any existing values are ignored.
+// A recurring item without a start time is scheduled for the next time given by its recurrence.
+// The items in DependsOn must be in the same queue; those which haven't yet completed block the
+// item from being claimed.  ErrInvalid is returned if the item's category doesn't allow
+// downloads from its source's host.
+func (s *store) EnqueueItem(queue string, item *Item) (string, error) {
+	return s.enqueue(queue, item, nil)
+}
+
+// EnqueueUniqueItem enqueues the item as EnqueueItem does, unless it duplicates an item by the
+// fields which check compares.  If it does, the item isn't enqueued, and ErrDuplicate is returned
+// with the id of the item it duplicates.
+func (s *store) EnqueueUniqueItem(queue string, item *Item, check DuplicateCheck) (string, error) {
+	return s.enqueue(queue, item, &check)
+}
+
+func (s *store) enqueue(queue string, item *Item, check *DuplicateCheck) (string, error) {
+	q := sanitiseQueueName(queue)
+	err := s.backend.update(func(tx txn) error {
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		meta.applyDefaults(item)
+		if err := checkAllowedHosts(tx, item); err != nil {
+			return err
+		}
+		if check != nil {
+			existing, err := findDuplicate(tx, q, item, *check)
+			if err != nil {
+				return err
+			}
+			if existing != "" {
+				return ErrDuplicate{ID: existing}
+			}
+		}
+		if err := resolveDependencies(tx, q, item); err != nil {
+			return err
+		}
+		if item.Recurrence != "" && item.NotBefore == nil {
+			next, err := nextRun(item.Recurrence, time.Now())
+			if err != nil {
+				return err
+			}
+			item.NotBefore = timestamppb.New(next)
+		}
+		return enqueueItem(tx, q, item)
+	})
+	if err != nil {
+		return "", err
+	}
+	return item.Id, nil
+}
+
+// enqueueItem assigns the item an id and a position at the back of queue q, and stores it.
+func enqueueItem(tx txn, q string, item *Item) error {
+	id, err := tx.nextSequence(q)
+	if err != nil {
+		return fmt.Errorf("error getting next sequence: %w", err)
+	}
+
+	setTargetKeys(item)
+	item.Id = fmt.Sprintf("%s"+idSeparator+"%020d", q, id)
+	item.Position = int64(id) * positionSpacing
+	item.Updated = timestamppb.Now()
+	return tx.putItem(q, item)
+}
+
+// SetItemState records the state reported for a claimed item, and returns the other changes that
+// resulted.  If the item failed and is to be retried, the rescheduled item is among the updated
+// items.  lease is the token returned with the claim; see getLeasedItem.
+func (s *store) SetItemState(id string, lease uint64, state queue.ItemState_State, bytesDownloaded uint64, totalSizeBytes uint64, class FailureClass, err error) (*Changes, error) {
+	switch state {
+	case queue.ItemState_ITEM_STATE_UNSPECIFIED:
+		return nil, fmt.Errorf("state was not specified")
+
+	case queue.ItemState_ITEM_STATE_FAILED:
+		return s.FailItem(id, lease, bytesDownloaded, totalSizeBytes, class, err)
+
+	case queue.ItemState_ITEM_STATE_COMPLETE:
+		return s.CompleteItem(id, lease, totalSizeBytes)
+
+	case queue.ItemState_ITEM_STATE_DOWNLOADING:
+		return &Changes{}, s.SetProgress(id, lease, bytesDownloaded, totalSizeBytes)
+
+	default:
+		return nil, fmt.Errorf("unrecognised state: %v, %v", int32(state), queue.ItemState_State_name[int32(state)])
+	}
+
+}
+
+func (s *store) SetProgress(id string, lease uint64, bytesDownloaded uint64, totalSizeBytes uint64) error {
+	return s.backend.update(func(tx txn) error {
+		q, item, err := getLeasedItem(tx, id, lease)
+		if err != nil {
+			return err
+		}
+		item.TotalSizeBytes = totalSizeBytes
+		item.DownloadedBytes = bytesDownloaded
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		item.ClaimExpiry = timestamppb.New(time.Now().Add(meta.ClaimTTLOrDefault()))
+		item.Updated = timestamppb.Now()
+
+		return tx.putItem(q, item)
+	})
+}
+
+// CompleteItem moves the item to the queue's history.  If the item is recurring, its next run is
+// enqueued.  Items which depend on it stop waiting for it.
+func (s *store) CompleteItem(id string, lease uint64, totalSizeBytes uint64) (*Changes, error) {
+	return s.moveItemToFinished(id, lease, totalSizeBytes, totalSizeBytes, FinishedItem_ITEM_STATE_SUCCESS, "")
+}
+
+// FailItem records a failed attempt at downloading the item.  If the item's retry policy allows
+// another attempt, the item stays in the queue but can't be claimed until its backoff has
+// elapsed, and the rescheduled item is returned among the updated items.  Otherwise the item is
+// moved to the queue's history, the next run of a recurring item is enqueued, and the items which
+// depend on it are failed or held according to their policies.
+func (s *store) FailItem(id string, lease uint64, downloadedBytes, totalSizeBytes uint64, class FailureClass, cause error) (*Changes, error) {
+	ch := &Changes{}
+	txErr := s.backend.update(func(tx txn) error {
+		q, item, err := getLeasedItem(tx, id, lease)
+		if err != nil {
+			return err
+		}
+		policy, err := retryPolicy(tx, q, item)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		item.Attempts = append(item.Attempts, &Attempt{
+			Finished:        timestamppb.New(now),
+			FailureClass:    class,
+			Message:         cause.Error(),
+			DownloadedBytes: downloadedBytes,
+		})
+		if !policy.retries(len(item.Attempts), class) {
+			_, err = finishItem(tx, q, item, downloadedBytes, totalSizeBytes, FinishedItem_ITEM_STATE_FAILED, cause.Error(), ch)
+			return err
+		}
+
+		item.NotBefore = timestamppb.New(now.Add(policy.backoff(len(item.Attempts))))
+		takeNextSource(item)
+		item.ClaimExpiry = nil
+		item.Lease = 0
+		item.DownloadedBytes = 0
+		item.TotalSizeBytes = totalSizeBytes
+		item.Updated = timestamppb.New(now)
+		ch.Updated = append(ch.Updated, item)
+		return tx.putItem(q, item)
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+	return ch, nil
+}
+
+// CancelItem moves the item to the queue's history.  Cancelling a recurring item stops it
+// recurring, and items which depend on it are failed or held according to their policies.
+func (s *store) CancelItem(id string) (*Changes, error) {
+	return s.moveItemToFinished(id, 0, 0, 0, FinishedItem_ITEM_STATE_CANCELLED, "cancelled by user")
+}
+
+// GetQueueItems returns a page of the queue's items which match the query.  nextCursor is empty
+// if there are no more matching items.
+func (s *store) GetQueueItems(queueID string, query ItemQuery) (queueItems []*Item, nextCursor string, err error) {
+	return getQueueItems(s, activeItems, queueID, query)
+}
+
+// MoveItem places the item with the given id immediately before, or after, the item relativeTo.
+// The item takes on the priority of relativeTo.
+func (s *store) MoveItem(id string, relativeTo string, after bool) (*Item, error) {
+	if id == relativeTo {
+		return nil, ErrInvalid{}
+	}
+	q, err := queueKeyFromItemID(id)
+	if err != nil {
+		return nil, ErrInvalid{}
+	}
+	if rq, err := queueKeyFromItemID(relativeTo); err != nil || rq != q {
+		return nil, ErrInvalid{}
+	}
+	return s.moveItem(id, placeNextTo(relativeTo, after))
+}
+
+// MoveToFront places the item with the given id at the front of its queue, raising its priority
+// to that of the first item in the queue if necessary.
+func (s *store) MoveToFront(id string) (*Item, error) {
+	return s.moveItem(id, placeAtFront)
+}
+
+// MoveToBack places the item with the given id at the back of its queue, lowering its priority
+// to that of the last item in the queue if necessary.
+func (s *store) MoveToBack(id string) (*Item, error) {
+	return s.moveItem(id, placeAtBack)
+}
+
+// ItemUpdate holds the changes UpdateItem makes to an item.  Nil fields are left as they are.
+type ItemUpdate struct {
+	Source      *Target
+	Destination *Target
+	Category    *Category
+	// NextAttempt allows the source of a claimed item to be changed.  The new source is kept in
+	// the item's NextSource until the item is next claimed, so the download in progress carries
+	// on from the old one.
+	NextAttempt bool
+}
+
+// UpdateItem changes the source, destination or category of an active item without moving it.
+// The queue's defaults are applied to the new values as they are when enqueueing.  An item with
+// a live claim can't be changed, and ErrPrecondition is returned, unless the update only changes
+// its source for the next attempt.  A new source or category must allow downloads from the
+// item's source's host, or ErrInvalid is returned.
+func (s *store) UpdateItem(id string, update ItemUpdate) (*Item, error) {
+	var item *Item
+	err := s.backend.update(func(tx txn) error {
+		q, it, err := getActiveItem(tx, id)
+		if err != nil {
+			return err
+		}
+		item = it
+		now := time.Now()
+		if item.ClaimExpiry.AsTime().After(now) {
+			if !update.NextAttempt {
+				return fmt.Errorf("item %v is being downloaded, so can only have its source changed for its next attempt: %w", id, ErrPrecondition{})
+			}
+			if update.Destination != nil || update.Category != nil {
+				return fmt.Errorf("only the source of item %v can be changed while it is being downloaded: %w", id, ErrPrecondition{})
+			}
+			if update.Source != nil {
+				item.NextSource = update.Source
+				setTargetKeys(item)
+				if err := checkAllowedHosts(tx, item); err != nil {
+					return err
+				}
+			}
+			item.Updated = timestamppb.New(now)
+			return tx.putItem(q, item)
+		}
+
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		changes := &Item{Destination: update.Destination, Category: update.Category}
+		meta.applyDefaults(changes)
+		if update.Source != nil {
+			item.Source = update.Source
+			item.NextSource = nil
+		}
+		if update.Destination != nil {
+			item.Destination = changes.Destination
+		}
+		if update.Category != nil {
+			item.Category = changes.Category
+		}
+		if update.Source != nil || update.Category != nil {
+			if err := checkAllowedHosts(tx, item); err != nil {
+				return err
+			}
+		}
+		setTargetKeys(item)
+		item.Updated = timestamppb.New(now)
+		return tx.putItem(q, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// takeNextSource replaces the item's source with the one set for its next attempt, if any.
+func takeNextSource(item *Item) {
+	if item.NextSource == nil {
+		return
+	}
+	item.Source = item.NextSource
+	item.NextSource = nil
+}
+
+// GetFinishedItems returns a page of the queue's history which matches the query.  nextCursor is
+// empty if there are no more matching items.
+func (s *store) GetFinishedItems(queueID string, query ItemQuery) (queueItems []*FinishedItem, nextCursor string, err error) {
+	return getQueueItems(s, finishedItems, queueID, query)
+}
+
+// ClearHistory removes the items in the queue's history which match filter, or every item if
+// filter is nil, and returns how many were removed.
+func (s *store) ClearHistory(queueID string, filter *ItemFilter) (int, error) {
+	q := sanitiseQueueName(queueID)
+	var removed int
+	err := s.backend.update(func(tx txn) error {
+		var err error
+		if filter != nil {
+			removed, err = deleteFinishedWhere(tx, q, func(item *FinishedItem) bool {
+				return filter.matches(finishedItemFields(item))
+			})
+			return err
+		}
+		if removed, err = tx.finishedCount(q); err != nil {
+			return err
+		}
+		return tx.clearFinished(q)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// RequeueItems enqueues copies of finished items at the back of the queue, and returns them.  The
+// items are those in the queue's history with the given ids or, if ids is empty, those matching
+// filter, in the order in which they finished.  An id may only be given once.  The history is unchanged.  A copy of a failed item
+// records how much of it was downloaded, so that the download can carry on from there.  If any
+// item's category no longer allows downloads from its source's host, nothing is requeued.
+func (s *store) RequeueItems(queueID string, ids []string, filter *ItemFilter) ([]*Item, error) {
+	q := sanitiseQueueName(queueID)
+	var requeued []*Item
+	err := s.backend.update(func(tx txn) error {
+		var finished []*FinishedItem
+		for i, id := range ids {
+			if iq, err := queueKeyFromItemID(id); err != nil || iq != q {
+				return fmt.Errorf("item %v is not in queue %v: %w", id, q, ErrInvalid{})
+			}
+			if slices.Contains(ids[:i], id) {
+				return fmt.Errorf("item %v is given more than once: %w", id, ErrInvalid{})
+			}
+			item, err := tx.getFinished(q, id)
+			if err != nil {
+				return err
+			}
+			finished = append(finished, item)
+		}
+		if len(ids) == 0 && filter != nil {
+			err := tx.walkFinished(q, "", false, func(key string, item *FinishedItem) bool {
+				if filter.matches(finishedItemFields(item)) {
+					finished = append(finished, item)
+				}
+				return true
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, f := range finished {
+			item := requeuedCopy(f)
+			if err := checkAllowedHosts(tx, item); err != nil {
+				return fmt.Errorf("item %v: %w", f.Item.Id, err)
+			}
+			if err := enqueueItem(tx, q, item); err != nil {
+				return err
+			}
+			requeued = append(requeued, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return requeued, nil
+}
+
+// requeuedCopy returns a new item to download the finished item again.  It isn't recurring and has
+// no dependencies, since the original's next run and dependents have already been dealt with.
+func requeuedCopy(finished *FinishedItem) *Item {
+	item := finished.Item
+	out := &Item{
+		Source:       defaultIfEmpty(item.Source, item.NextSource),
+		Destination:  item.Destination,
+		Category:     item.Category,
+		Priority:     item.Priority,
+		RetryPolicy:  item.RetryPolicy,
+		RequeuedFrom: item.Id,
+	}
+	if finished.State == FinishedItem_ITEM_STATE_FAILED {
+		out.ResumeFromBytes = finished.DownloadedBytes
+		out.DownloadedBytes = finished.DownloadedBytes
+		out.TotalSizeBytes = finished.TotalSizeBytes
+	}
+	return out
+}
+
+// QueueStats counts the items in a queue.
+type QueueStats struct {
+	// Active counts the items in the queue by their state.
+	Active map[queue.ItemState_State]int
+	// Finished is the number of items in the queue's history.
+	Finished int
+}
+
+// QueueStats counts the queue's active items, by state, and the items in its history.
+func (s *store) QueueStats(queueID string) (*QueueStats, error) {
+	q := sanitiseQueueName(queueID)
+	out := &QueueStats{Active: map[queue.ItemState_State]int{}}
+	err := s.backend.view(func(tx txn) error {
+		items, err := tx.items(q)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, item := range items {
+			out.Active[item.State(now)]++
+		}
+		out.Finished, err = tx.finishedCount(q)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func getQueueItems[T proto.Message](s *store, l listed[T], queueID string, query ItemQuery) (queueItems []T, nextCursor string, err error) {
+	pageSize := query.Limit()
+	cur, err := decodeCursor(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = s.backend.view(func(tx txn) error {
+		q := sanitiseQueueName(queueID)
+		var page pager[T]
+		var err error
+		if query.Sort == SortDefault && l.keyOrdered {
+			page, err = walkItems(tx, q, l, query, cur, pageSize)
+		} else {
+			page, err = sortItems(tx, q, l, query, cur, pageSize)
+		}
+		if err != nil {
+			return fmt.Errorf("queue %s: %w", queueID, err)
+		}
+		queueItems = page.items
+		if page.more {
+			last := len(page.keys) - 1
+			nextCursor = cursor{
+				Sort:       query.Sort,
+				Descending: query.Descending,
+				Value:      l.sortValue(page.items[last], query.Sort),
+				Key:        page.keys[last],
+			}.encode()
+		}
+		return nil
+	})
+	return
+}
+
+// pager accumulates a page of items, noting whether any matching items were left over.
+type pager[T any] struct {
+	size  uint
+	items []T
+	keys  []string
+	more  bool
+}
+
+// add appends the item to the page.  It returns false, having recorded that there are more items
+// to come, if the page is already full.
+func (p *pager[T]) add(key string, item T) bool {
+	if uint(len(p.items)) >= p.size {
+		p.more = true
+		return false
+	}
+	p.items = append(p.items, item)
+	p.keys = append(p.keys, key)
+	return true
+}
+
+// walkItems builds a page by reading items in key order, starting after the cursor.
+func walkItems[T proto.Message](tx txn, q string, l listed[T], query ItemQuery, cur cursor, pageSize uint) (pager[T], error) {
+	page := pager[T]{size: pageSize, items: make([]T, 0, pageSize)}
+	err := l.walk(tx, q, cur.Key, query.Descending, func(key string, item T) bool {
+		if !query.Filter.matches(l.fields(item)) {
+			return true
+		}
+		return page.add(key, item)
+	})
+	return page, err
+}
+
+// sortItems builds a page by loading every matching item and sorting them.
+func sortItems[T proto.Message](tx txn, q string, l listed[T], query ItemQuery, cur cursor, pageSize uint) (pager[T], error) {
+	type entry struct {
+		key   string
+		value string
+		item  T
+	}
+	var entries []entry
+	err := l.walk(tx, q, "", false, func(key string, item T) bool {
+		if query.Filter.matches(l.fields(item)) {
+			entries = append(entries, entry{
+				key:   key,
+				value: l.sortValue(item, query.Sort),
+				item:  item,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return pager[T]{}, err
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		res := cmp.Or(strings.Compare(a.value, b.value), strings.Compare(a.key, b.key))
+		if query.Descending {
+			return -res
+		}
+		return res
+	})
+
+	page := pager[T]{size: pageSize, items: make([]T, 0, pageSize)}
+	for _, e := range entries {
+		if cur.Key != "" && !cur.after(e.value, e.key) {
+			continue
+		}
+		if !page.add(e.key, e.item) {
+			break
+		}
+	}
+	return page, nil
+}
+
+// Claim is an item claimed by ClaimNextItem, with the settings which apply while it is
+// downloaded.
+type Claim struct {
+	*Item
+	// ClaimTTL is how long the claim lasts without a progress report.
+	ClaimTTL time.Duration
+	// RateLimit is the item's download rate limit in bytes per second, from its category or else
+	// its queue.  Zero is unlimited.
+	RateLimit uint64
+	// CategorySettings holds the settings of the item's category, or nil if it has none.
+	CategorySettings *CategoryConfig
+	// ResumeFrom is how much of a requeued item an earlier download left in its destination.
+	// It only applies to the first claim of the item, since later attempts make their own
+	// progress.
+	ResumeFrom uint64
+}
+
+// ClaimNextItem claims the first item in the queue which is ready to be downloaded, recording the
+// given worker as the claim's holder.  It returns nil if no item is ready.  The settings which
+// apply to the item are read in the same transaction as the claim is made.
+func (s *store) ClaimNextItem(queue string, worker string) (*Claim, error) {
+	var nextItem *Item
+	var claim *Claim
+	var expired bool
+	q := sanitiseQueueName(queue)
+
+	err := s.backend.update(func(tx txn) error {
+		meta, err := tx.getQueueMeta(q)
+		if err != nil {
+			return err
+		}
+		if meta.Paused {
+			return nil
+		}
+
+		items, err := tx.items(q)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if limit := meta.MaxConcurrentClaims; limit > 0 && claimedCount(items, now) >= int(limit) {
+			// the queue already has as many items in progress as it allows
+			return nil
+		}
+		// only the first claimable item in queue order is needed, so find it without sorting
+		// the whole queue
+		for _, item := range items {
+			if item.NotBefore.AsTime().After(now) {
+				// the item is waiting to be retried
+				continue
+			}
+			if item.Blocked() {
+				continue
+			}
+			if !item.ClaimExpiry.AsTime().Before(now) {
+				// the item is claimed
+				continue
+			}
+			if nextItem == nil || compareItems(item, nextItem) < 0 {
+				nextItem = item
+			}
+		}
+		if nextItem == nil {
+			// we didn't find anything
+			return nil
+		}
+
+		// the claim expiry is only cleared when an item is returned to the queue, so an item
+		// which still has one was abandoned by the worker which claimed it
+		expired = nextItem.ClaimExpiry != nil
+		newExpiryTime := now.Add(meta.ClaimTTLOrDefault())
+		nextItem.ClaimExpiry = timestamppb.New(newExpiryTime)
+		nextItem.ClaimedBy = worker
+		// an abandoned item's source may have been changed during its last attempt
+		takeNextSource(nextItem)
+		resumeFrom := nextItem.ResumeFromBytes
+		nextItem.ResumeFromBytes = 0
+		// leases come from a per-queue sequence so that a new claim always supersedes an
+		// old one, even if the item has been claimed by the same worker before
+		if nextItem.Lease, err = tx.nextLease(q); err != nil {
+			return fmt.Errorf("error issuing lease: %w", err)
+		}
+		nextItem.Updated = timestamppb.New(now)
+		if err := tx.putItem(q, nextItem); err != nil {
+			return fmt.Errorf("error storing claimed item: %w", err)
+		}
+
+		claim = &Claim{Item: nextItem, ClaimTTL: meta.ClaimTTLOrDefault(), RateLimit: meta.DefaultRateLimit, ResumeFrom: resumeFrom}
+		category, err := tx.getCategory(nextItem.GetCategory().GetId())
+		switch {
+		case err == nil:
+			claim.CategorySettings = category
+			claim.RateLimit = cmp.Or(category.RateLimit, claim.RateLimit)
+		case !errors.As(err, &ErrNotFound{}):
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if claim != nil {
+		claims.WithLabelValues(q).Inc()
+		if expired {
+			expiredClaims.WithLabelValues(q).Inc()
+		}
+	}
+	return claim, nil
+}
+
+func (s *store) moveItemToFinished(id string, lease uint64, downloadedBytes uint64, totalSizeBytes uint64, state FinishedItem_State, message string) (*Changes, error) {
+	ch := &Changes{}
+	err := s.backend.update(func(tx txn) error {
+		q, item, err := getLeasedItem(tx, id, lease)
+		if err != nil {
+			return err
+		}
+		_, err = finishItem(tx, q, item, downloadedBytes, totalSizeBytes, state, message, ch)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// getActiveItem returns the key of the queue holding the item with the given id, and the item
+// itself.
+func getActiveItem(tx txn, id string) (string, *Item, error) {
+	q, err := queueKeyFromItemID(id)
+	if err != nil {
+		return "", nil, ErrInvalid{}
+	}
+	item, err := tx.getItem(q, id)
+	if err != nil {
+		return "", nil, err
+	}
+	return q, item, nil
+}
+
+// getLeasedItem is getActiveItem for an update by the holder of a claim.  If lease is non-zero, it
+// must be the token issued with the item's current claim; an update from a downloader whose claim
+// has been superseded, or which has already reported a failure, is rejected with
+// ErrPrecondition.  A zero lease skips the check.
+func getLeasedItem(tx txn, id string, lease uint64) (string, *Item, error) {
+	q, item, err := getActiveItem(tx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	if lease != 0 && lease != item.Lease {
+		return "", nil, fmt.Errorf("lease %d on item %v is no longer current: %w", lease, id, ErrPrecondition{})
+	}
+	return q, item, nil
+}
+
+// finishItem moves the item from the queue's active items to its history, and resolves the items
+// which depend on it.  Unless the item was cancelled, a recurring item's next run is enqueued.
+// Other items affected are recorded in ch.
+func finishItem(tx txn, q string, item *Item, downloadedBytes uint64, totalSizeBytes uint64, state FinishedItem_State, message string, ch *Changes) (*FinishedItem, error) {
+	key, err := orderedKey()
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate key: %w", err)
+	}
+
+	finished := &FinishedItem{
+		State:           state,
+		TotalSizeBytes:  totalSizeBytes,
+		DownloadedBytes: downloadedBytes,
+		Timestamp:       timestamppb.New(time.Now()),
+		Message:         message,
+		Item:            item,
+	}
+	if err := tx.putFinished(q, key, finished); err != nil {
+		return nil, fmt.Errorf("unable to write finished item: %w", err)
+	}
+	if err := tx.deleteItem(q, item.Id); err != nil {
+		return nil, fmt.Errorf("inable to delete item from queue: %w", err)
+	}
+	if err := resolveDependents(tx, q, item.Id, state, ch); err != nil {
+		return nil, fmt.Errorf("unable to resolve items depending on %v: %w", item.Id, err)
+	}
+
+	if item.Recurrence == "" || state == FinishedItem_ITEM_STATE_CANCELLED {
+		return finished, nil
+	}
+	runAt, err := nextRun(item.Recurrence, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule next run of %v: %w", item.Id, err)
+	}
+	next := &Item{
+		Source:      defaultIfEmpty(item.Source, item.NextSource),
+		Destination: item.Destination,
+		Category:    item.Category,
+		Priority:    item.Priority,
+		RetryPolicy: item.RetryPolicy,
+		Recurrence:  item.Recurrence,
+		NotBefore:   timestamppb.New(runAt),
+		PreviousRun: item.Id,
+	}
+	if err := enqueueItem(tx, q, next); err != nil {
+		return nil, fmt.Errorf("unable to enqueue next run of %v: %w", item.Id, err)
+	}
+	ch.Enqueued = append(ch.Enqueued, next)
+	return finished, nil
+}
+
+func orderedKey() (string, error) {
+	const size = 128
+	out := make([]byte, size)
+	n, err := rand.Read(out)
+	if err != nil {
+		return "", fmt.Errorf("error reading random data: %w", err)
+	}
+	if n != size {
+		return "", fmt.Errorf("did not fill buffer: %w", err)
+	}
+
+	now := uint64(time.Now().UnixNano())
+	binary.BigEndian.PutUint64(out, now)
+
+	return hex.EncodeToString(out), nil
+}
+
+// QueueIDFromItemID returns the identifier of the queue that holds the item with the given id.
+func QueueIDFromItemID(id string) (string, error) {
+	return queueKeyFromItemID(id)
+}
+
+func queueKeyFromItemID(id string) (string, error) {
+	col := strings.Index(id, idSeparator)
+	// a queue's name may be a single character, so only an empty one, or no separator, is invalid
+	if col < 1 {
+		return "", ErrInvalid{}
+	}
+	return id[:col], nil
+}
+
+var invalidQueueChars = regexp.MustCompile("[^a-zA-Z0-9_-]")
+
+// QueueID returns the identifier under which a queue with the given name is stored.
+func QueueID(name string) string {
+	return sanitiseQueueName(name)
+}
+
+func sanitiseQueueName(in string) string {
+	return invalidQueueChars.ReplaceAllLiteralString(in, "_")
+}
+
+func defaultIfEmpty[T comparable](def T, act T) T {
+	var empty T
+	if act == empty {
+		return def
+	}
+	return act
+}
+
+func min[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return b
+	}
+	return a
+}
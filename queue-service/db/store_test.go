@@ -0,0 +1,324 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"github.com/harryrose/godm/queue-service/queue"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// openTestStore opens a store with the given backend, which is closed when the test ends, and
+// creates the queue "q" in it with the given settings.
+func openTestStore(t *testing.T, backend string, meta *Queue) Store {
+	t.Helper()
+	s, err := Open(backend, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("unable to open store: %v", err)
+	}
+	t.Cleanup(func() {
+		s.Close()
+	})
+	if _, err := s.CreateQueue("q", meta); err != nil {
+		t.Fatalf("unable to create queue: %v", err)
+	}
+	return s
+}
+
+// TestStore_Backends runs the same operations against each backend, which should behave alike.
+func TestStore_Backends(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			if _, err := s.CreateQueue("q", nil); !errors.As(err, &ErrConflict{}) {
+				t.Errorf("expected creating a queue twice to conflict, got %v", err)
+			}
+			if _, err := s.GetQueue("missing"); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected a missing queue not to be found, got %v", err)
+			}
+
+			ids := enqueueTestItems(t, s, 0, 0, 0, 0, 0)
+			// complete the items in order, so that the history holds them oldest first
+			for _, id := range ids {
+				claimed, err := s.ClaimNextItem("q", "w")
+				if err != nil || claimed == nil || claimed.Id != id {
+					t.Fatalf("expected to claim %v, got %v, %v", id, claimed, err)
+				}
+				if _, err := s.SetItemState(id, claimed.Lease, queue.ItemState_ITEM_STATE_COMPLETE, 10, 10, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+					t.Fatalf("unable to complete %v: %v", id, err)
+				}
+			}
+			if claimed, err := s.ClaimNextItem("q", "w"); err != nil || claimed != nil {
+				t.Errorf("expected an empty queue, got %v, %v", claimed, err)
+			}
+
+			for _, descending := range []bool{false, true} {
+				var got []string
+				cursor := ""
+				for {
+					items, next, err := s.GetFinishedItems("q", ItemQuery{Cursor: cursor, PageSize: 2, Descending: descending})
+					if err != nil {
+						t.Fatalf("unable to list history: %v", err)
+					}
+					for _, item := range items {
+						got = append(got, item.Item.Id)
+					}
+					if next == "" {
+						break
+					}
+					cursor = next
+				}
+				want := slices.Clone(ids)
+				if descending {
+					slices.Reverse(want)
+				}
+				if !slices.Equal(got, want) {
+					t.Errorf("descending %v: expected history %v, got %v", descending, want, got)
+				}
+			}
+
+			// a dependency on a completed item is already satisfied
+			id, err := s.EnqueueItem("q", &Item{
+				Source:      &Target{Url: "http://example.com"},
+				Destination: &Target{Url: "file://example"},
+				DependsOn:   []string{ids[0]},
+			})
+			if err != nil {
+				t.Fatalf("unable to enqueue dependent item: %v", err)
+			}
+			items, _, err := s.GetQueueItems("q", ItemQuery{})
+			if err != nil || len(items) != 1 || items[0].Id != id || items[0].Blocked() {
+				t.Errorf("expected the unblocked item %v, got %v, %v", id, items, err)
+			}
+			stats, err := s.QueueStats("q")
+			if err != nil || stats.Active[queue.ItemState_ITEM_STATE_QUEUED] != 1 || len(stats.Active) != 1 || stats.Finished != len(ids) {
+				t.Errorf("expected 1 queued item and %d finished, got %+v, %v", len(ids), stats, err)
+			}
+
+			// a failed update leaves the store unchanged
+			_, err = s.UpdateQueue("q", func(meta *Queue) error {
+				meta.MaxConcurrentClaims = 5
+				return ErrInvalid{}
+			})
+			if !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected the update's error, got %v", err)
+			}
+			if meta, err := s.GetQueue("q"); err != nil || meta.MaxConcurrentClaims != 0 {
+				t.Errorf("expected the failed update to be discarded, got %v, %v", meta, err)
+			}
+
+			if _, err := s.ClearHistory("q", nil); err != nil {
+				t.Fatalf("unable to clear history: %v", err)
+			}
+			if items, _, err := s.GetFinishedItems("q", ItemQuery{}); err != nil || len(items) != 0 {
+				t.Errorf("expected an empty history, got %v, %v", items, err)
+			}
+			if stats, err := s.QueueStats("q"); err != nil || stats.Finished != 0 {
+				t.Errorf("expected no finished items, got %+v, %v", stats, err)
+			}
+		})
+	}
+}
+
+// TestStore_QueueLifecycle renames, pauses and deletes a queue in each backend.
+func TestStore_QueueLifecycle(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			if _, err := s.CreateQueue("other", nil); err != nil {
+				t.Fatalf("unable to create queue: %v", err)
+			}
+			ids := enqueueTestItems(t, s, 0, 0)
+
+			if _, err := s.RenameQueue("q", "other"); !errors.As(err, &ErrConflict{}) {
+				t.Errorf("expected renaming to another queue's name to conflict, got %v", err)
+			}
+			if _, err := s.RenameQueue("q", "Renamed"); err != nil {
+				t.Fatalf("unable to rename queue: %v", err)
+			}
+			if _, err := s.CreateQueue("Renamed", nil); !errors.As(err, &ErrConflict{}) {
+				t.Errorf("expected creating a queue with a taken name to conflict, got %v", err)
+			}
+			queues, err := s.ListQueues()
+			if err != nil || len(queues) != 2 || queues[0].Id != "other" || queues[1].Id != "q" || queues[1].Name != "Renamed" {
+				t.Errorf("expected the renamed queue to keep its id, got %v, %v", queues, err)
+			}
+
+			if _, err := s.UpdateQueue("q", func(meta *Queue) error {
+				meta.Paused = true
+				return nil
+			}); err != nil {
+				t.Fatalf("unable to pause queue: %v", err)
+			}
+			if claimed, err := s.ClaimNextItem("q", "w"); err != nil || claimed != nil {
+				t.Errorf("expected nothing to be claimed from a paused queue, got %v, %v", claimed, err)
+			}
+			if _, err := s.EnqueueItem("q", &Item{Source: &Target{Url: "http://example.com"}, Destination: &Target{Url: "file://example"}}); err != nil {
+				t.Errorf("expected a paused queue to accept items, got %v", err)
+			}
+
+			var archive bytes.Buffer
+			if err := s.DeleteQueue("q", &archive); err != nil {
+				t.Fatalf("unable to delete queue: %v", err)
+			}
+			if _, err := s.GetQueue("q"); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected the deleted queue not to be found, got %v", err)
+			}
+			if err := s.DeleteQueue("q", nil); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected deleting a missing queue to fail, got %v", err)
+			}
+			if _, err := s.GetQueue("other"); err != nil {
+				t.Errorf("expected the other queue to remain, got %v", err)
+			}
+
+			summary, err := s.Import(&archive)
+			if err != nil || summary.Queues != 1 || summary.Items != len(ids)+1 {
+				t.Fatalf("expected the archive to restore the queue and its items, got %+v, %v", summary, err)
+			}
+			if meta, err := s.GetQueue("q"); err != nil || meta.Name != "Renamed" || !meta.Paused {
+				t.Errorf("expected the restored queue's record, got %v, %v", meta, err)
+			}
+		})
+	}
+}
+
+func TestStore_UpdateItem(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, &Queue{
+				DefaultDestinationPrefix: "downloads",
+				RetryPolicy:              &RetryPolicy{MaxAttempts: 2},
+			})
+			ids := enqueueTestItems(t, s, 0, 0)
+
+			item, err := s.UpdateItem(ids[1], ItemUpdate{
+				Destination: &Target{Url: "file://renamed"},
+				Category:    &Category{Id: "other"},
+			})
+			if err != nil {
+				t.Fatalf("unable to update item: %v", err)
+			}
+			if item.Destination.Url != "file://downloads/renamed" || item.Category.Id != "other" || item.Source.Url != "http://example.com" {
+				t.Errorf("expected the destination and category to change, got %v", item)
+			}
+			items, _, err := s.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil || len(items) != 2 || items[1].Id != ids[1] || items[1].Destination.Url != "file://downloads/renamed" {
+				t.Errorf("expected the updated item to keep its position, got %v, %v", items, err)
+			}
+
+			claimed, err := s.ClaimNextItem("q", "w")
+			if err != nil || claimed.Id != ids[0] {
+				t.Fatalf("unable to claim item: %v, %v", claimed, err)
+			}
+			if _, err := s.UpdateItem(ids[0], ItemUpdate{Source: &Target{Url: "http://mirror.example.com"}}); !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected updating a claimed item to fail, got %v", err)
+			}
+			if _, err := s.UpdateItem(ids[0], ItemUpdate{Destination: &Target{Url: "file://other"}, NextAttempt: true}); !errors.As(err, &ErrPrecondition{}) {
+				t.Errorf("expected changing a claimed item's destination to fail, got %v", err)
+			}
+			item, err = s.UpdateItem(ids[0], ItemUpdate{Source: &Target{Url: "http://mirror.example.com"}, NextAttempt: true})
+			if err != nil || item.Source.Url != "http://example.com" || item.NextSource.GetUrl() != "http://mirror.example.com" {
+				t.Fatalf("expected the source to be changed for the next attempt, got %v, %v", item, err)
+			}
+
+			ch, err := s.SetItemState(ids[0], claimed.Lease, queue.ItemState_ITEM_STATE_FAILED, 0, 0, FailureClass_FAILURE_CLASS_NETWORK, errors.New("failed"))
+			if err != nil {
+				t.Fatalf("unable to fail item: %v", err)
+			}
+			if len(ch.Updated) != 1 || ch.Updated[0].Source.Url != "http://mirror.example.com" || ch.Updated[0].NextSource != nil {
+				t.Errorf("expected the retry to use the new source, got %v", ch.Updated)
+			}
+		})
+	}
+}
+
+func TestStore_RequeueItems(t *testing.T) {
+	for _, backend := range Backends {
+		t.Run(backend, func(t *testing.T) {
+			s := openTestStore(t, backend, nil)
+			ids := enqueueTestItems(t, s, 2, 1, 0)
+
+			claimed, err := s.ClaimNextItem("q", "w")
+			if err != nil || claimed.Id != ids[0] {
+				t.Fatalf("unable to claim item: %v, %v", claimed, err)
+			}
+			if _, err := s.SetItemState(ids[0], claimed.Lease, queue.ItemState_ITEM_STATE_FAILED, 100, 1000, FailureClass_FAILURE_CLASS_SERVER, errors.New("unavailable")); err != nil {
+				t.Fatalf("unable to fail item: %v", err)
+			}
+			if _, err := s.CancelItem(ids[1]); err != nil {
+				t.Fatalf("unable to cancel item: %v", err)
+			}
+			claimed, err = s.ClaimNextItem("q", "w")
+			if err != nil || claimed.Id != ids[2] {
+				t.Fatalf("unable to claim item: %v, %v", claimed, err)
+			}
+			if _, err := s.SetItemState(ids[2], claimed.Lease, queue.ItemState_ITEM_STATE_COMPLETE, 1000, 1000, FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+				t.Fatalf("unable to complete item: %v", err)
+			}
+
+			requeued, err := s.RequeueItems("q", nil, &ItemFilter{States: []queue.ItemState_State{queue.ItemState_ITEM_STATE_FAILED}, Category: "default"})
+			if err != nil || len(requeued) != 2 {
+				t.Fatalf("expected the failed and cancelled items to be requeued, got %v, %v", requeued, err)
+			}
+			if requeued[0].RequeuedFrom != ids[0] || requeued[0].ResumeFromBytes != 100 || requeued[0].Attempts != nil {
+				t.Errorf("expected a fresh copy of the failed item which resumes from its progress, got %v", requeued[0])
+			}
+			if requeued[1].RequeuedFrom != ids[1] || requeued[1].ResumeFromBytes != 0 {
+				t.Errorf("expected a copy of the cancelled item, got %v", requeued[1])
+			}
+
+			requeued, err = s.RequeueItems("q", []string{ids[2]}, nil)
+			if err != nil || len(requeued) != 1 || requeued[0].RequeuedFrom != ids[2] || requeued[0].ResumeFromBytes != 0 {
+				t.Errorf("expected the completed item to be requeued from the start, got %v, %v", requeued, err)
+			}
+			if _, err := s.RequeueItems("q", []string{"other:00000000000000000001"}, nil); !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected requeueing another queue's item to fail, got %v", err)
+			}
+			if _, err := s.RequeueItems("q", []string{"q:00000000000000000099"}, nil); !errors.As(err, &ErrNotFound{}) {
+				t.Errorf("expected requeueing a missing item to fail, got %v", err)
+			}
+			// a repeated id would otherwise queue two copies of the item
+			if _, err := s.RequeueItems("q", []string{ids[0], ids[2], ids[0]}, nil); !errors.As(err, &ErrInvalid{}) {
+				t.Errorf("expected requeueing an item twice to fail, got %v", err)
+			}
+
+			active, _, err := s.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil || len(active) != 3 {
+				t.Errorf("expected the copies to be queued, got %v, %v", active, err)
+			}
+			finished, _, err := s.GetFinishedItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil || len(finished) != 3 {
+				t.Errorf("expected the history to be unchanged, got %v, %v", finished, err)
+			}
+
+			// only the first attempt at the copy resumes the earlier download
+			claimed, err = s.ClaimNextItem("q", "w")
+			if err != nil || claimed.Id != active[0].Id || claimed.ResumeFrom != 100 {
+				t.Fatalf("expected to claim the failed item's copy, resuming from 100 bytes, got %v, %v", claimed, err)
+			}
+			active, _, err = s.GetQueueItems("q", ItemQuery{PageSize: MaxPageSize})
+			if err != nil || active[0].ResumeFromBytes != 0 {
+				t.Errorf("expected the resume offset to be cleared once claimed, got %v, %v", active, err)
+			}
+		})
+	}
+}
+
+func TestQueueIDFromItemID(t *testing.T) {
+	tests := map[string]string{
+		"downloads:11": "downloads",
+		"q:11":         "q",
+		"q:a:b":        "q",
+	}
+	for id, expected := range tests {
+		if got, err := QueueIDFromItemID(id); err != nil || got != expected {
+			t.Errorf("expected the queue of %q to be %q, got %q, %v", id, expected, got, err)
+		}
+	}
+	for _, id := range []string{"", "11", ":11"} {
+		if got, err := QueueIDFromItemID(id); !errors.As(err, &ErrInvalid{}) {
+			t.Errorf("expected %q to be invalid, got %q, %v", id, got, err)
+		}
+	}
+}
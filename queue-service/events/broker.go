@@ -0,0 +1,200 @@
+package events
+
+import (
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRetain       = 1024
+	subscriberQueueSize = 256
+)
+
+// Broker fans out queue events to any subscribers watching the queue.  A short history of
+// events is kept for each queue so that subscribers which disconnect can resume without
+// missing anything.
+//
+// Sequence numbers for a queue start at a value derived from the time the queue was first
+// seen by the broker, so that sequence numbers issued before a restart of the service are
+// always older than those retained after it.
+type Broker struct {
+	// Retain is the number of events to keep per queue for resuming subscribers.  If zero,
+	// DefaultRetain is used.
+	Retain int
+
+	mux    sync.Mutex
+	queues map[string]*queueLog
+	closed bool
+}
+
+type queueLog struct {
+	seq    uint64
+	recent []*rpc.QueueEvent
+	subs   map[*Subscription]struct{}
+}
+
+// Subscription receives the events published for a single queue.
+type Subscription struct {
+	queue   string
+	c       chan *rpc.QueueEvent
+	lagged  bool
+	removed bool
+}
+
+// C returns the channel on which events are delivered.  It is closed when the subscription
+// ends, either because it was cancelled, because the subscriber fell too far behind, or because
+// the broker was closed, or because the queue was removed.
+func (s *Subscription) C() <-chan *rpc.QueueEvent {
+	return s.c
+}
+
+// Lagged reports whether the subscription was ended because the subscriber did not keep up.
+// It should only be called once C has been closed.
+func (s *Subscription) Lagged() bool {
+	return s.lagged
+}
+
+// Removed reports whether the subscription was ended because its queue was removed.  It should
+// only be called once C has been closed.
+func (s *Subscription) Removed() bool {
+	return s.removed
+}
+
+// Publish assigns the next sequence number and a timestamp to ev, and delivers it to all
+// subscribers of the queue.
+func (b *Broker) Publish(queueID string, ev *rpc.QueueEvent) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	q := b.queue(queueID)
+	q.seq++
+	ev.Sequence = q.seq
+	ev.Timestamp = timestamppb.Now()
+
+	q.recent = append(q.recent, ev)
+	if retain := defaultIfZero(DefaultRetain, b.Retain); len(q.recent) > retain {
+		q.recent = q.recent[len(q.recent)-retain:]
+	}
+
+	for sub := range q.subs {
+		select {
+		case sub.c <- ev:
+		default:
+			// the subscriber isn't keeping up.  drop it rather than block publishers;
+			// it can resume from the last event it received.
+			sub.lagged = true
+			b.remove(q, sub)
+		}
+	}
+}
+
+// Subscribe registers a new subscriber for the queue.  If resumeFrom identifies an event that
+// is still retained, backlog contains the events which followed it and resumed is true.
+// Otherwise the caller should send a snapshot which reflects every event up to and including
+// head.  Events published after head are delivered on the subscription.
+func (b *Broker) Subscribe(queueID string, resumeFrom uint64) (sub *Subscription, head uint64, backlog []*rpc.QueueEvent, resumed bool) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	q := b.queue(queueID)
+	sub = &Subscription{
+		queue: queueID,
+		c:     make(chan *rpc.QueueEvent, subscriberQueueSize),
+	}
+	if b.closed {
+		close(sub.c)
+	} else {
+		q.subs[sub] = struct{}{}
+	}
+	head = q.seq
+
+	if resumeFrom == 0 || resumeFrom > q.seq {
+		return sub, head, nil, false
+	}
+	if resumeFrom == q.seq {
+		return sub, head, nil, true
+	}
+	if len(q.recent) == 0 || q.recent[0].Sequence > resumeFrom+1 {
+		// the events following resumeFrom have already been discarded
+		return sub, head, nil, false
+	}
+	for _, ev := range q.recent {
+		if ev.Sequence > resumeFrom {
+			backlog = append(backlog, ev)
+		}
+	}
+	return sub, head, backlog, true
+}
+
+// Unsubscribe ends the subscription and closes its channel.  It is safe to call more than once.
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	if q, ok := b.queues[sub.queue]; ok {
+		b.remove(q, sub)
+	}
+}
+
+// RemoveQueue ends the queue's subscriptions and discards its events, as the queue has been
+// deleted.
+func (b *Broker) RemoveQueue(queueID string) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	q, ok := b.queues[queueID]
+	if !ok {
+		return
+	}
+	for sub := range q.subs {
+		sub.removed = true
+		b.remove(q, sub)
+	}
+	delete(b.queues, queueID)
+}
+
+// Close ends every subscription, and any made after it, so that watchers stop when the service
+// is shutting down.
+func (b *Broker) Close() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	b.closed = true
+	for _, q := range b.queues {
+		for sub := range q.subs {
+			b.remove(q, sub)
+		}
+	}
+}
+
+func (b *Broker) remove(q *queueLog, sub *Subscription) {
+	if _, ok := q.subs[sub]; !ok {
+		return
+	}
+	delete(q.subs, sub)
+	close(sub.c)
+}
+
+func (b *Broker) queue(queueID string) *queueLog {
+	if b.queues == nil {
+		b.queues = make(map[string]*queueLog)
+	}
+	q, ok := b.queues[queueID]
+	if !ok {
+		q = &queueLog{
+			seq:  uint64(time.Now().UnixMicro()),
+			subs: make(map[*Subscription]struct{}),
+		}
+		b.queues[queueID] = q
+	}
+	return q
+}
+
+func defaultIfZero(def, val int) int {
+	if val == 0 {
+		return def
+	}
+	return val
+}
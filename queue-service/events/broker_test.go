@@ -0,0 +1,135 @@
+package events
+
+import (
+	"github.com/harryrose/godm/queue-service/rpc"
+	"testing"
+)
+
+func TestBroker_Subscribe(t *testing.T) {
+	b := &Broker{Retain: 3}
+
+	first := &rpc.QueueEvent{}
+	b.Publish("q", first)
+	for i := 0; i < 4; i++ {
+		b.Publish("q", &rpc.QueueEvent{})
+	}
+	head := first.Sequence + 4
+
+	tests := []struct {
+		name        string
+		resumeFrom  uint64
+		wantResumed bool
+		wantBacklog int
+	}{
+		{"new watcher", 0, false, 0},
+		{"up to date", head, true, 0},
+		{"within retained events", head - 2, true, 2},
+		{"oldest retained event", head - 3, true, 3},
+		{"events discarded", head - 4, false, 0},
+		{"from before a restart", first.Sequence - 100, false, 0},
+		{"from the future", head + 1, false, 0},
+	}
+
+	for _, tt := range tests {
+		sub, gotHead, backlog, resumed := b.Subscribe("q", tt.resumeFrom)
+		if gotHead != head {
+			t.Errorf("%s: expected head %v, got %v", tt.name, head, gotHead)
+		}
+		if resumed != tt.wantResumed {
+			t.Errorf("%s: expected resumed to be %v", tt.name, tt.wantResumed)
+		}
+		if len(backlog) != tt.wantBacklog {
+			t.Errorf("%s: expected %v events in backlog, got %v", tt.name, tt.wantBacklog, len(backlog))
+		}
+		for i, ev := range backlog {
+			if expected := tt.resumeFrom + uint64(i) + 1; ev.Sequence != expected {
+				t.Errorf("%s: expected backlog event %v to have sequence %v, got %v", tt.name, i, expected, ev.Sequence)
+			}
+		}
+		b.Unsubscribe(sub)
+	}
+}
+
+func TestBroker_Publish(t *testing.T) {
+	b := &Broker{}
+	sub, head, _, _ := b.Subscribe("q", 0)
+	other, _, _, _ := b.Subscribe("other", 0)
+
+	b.Publish("q", &rpc.QueueEvent{Type: rpc.QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED})
+
+	select {
+	case ev := <-sub.C():
+		if ev.Sequence != head+1 {
+			t.Errorf("expected sequence %v, got %v", head+1, ev.Sequence)
+		}
+		if ev.Timestamp == nil {
+			t.Errorf("expected the event to be timestamped")
+		}
+	default:
+		t.Fatalf("expected an event to be delivered")
+	}
+
+	select {
+	case <-other.C():
+		t.Errorf("did not expect an event for another queue")
+	default:
+	}
+
+	b.Unsubscribe(sub)
+	b.Unsubscribe(sub)
+	if _, ok := <-sub.C(); ok {
+		t.Errorf("expected the channel to be closed")
+	}
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	b := &Broker{}
+	sub, _, _, _ := b.Subscribe("q", 0)
+
+	for i := 0; i < subscriberQueueSize+1; i++ {
+		b.Publish("q", &rpc.QueueEvent{})
+	}
+
+	received := 0
+	for range sub.C() {
+		received++
+	}
+	if received != subscriberQueueSize {
+		t.Errorf("expected %v events before the subscriber was dropped, got %v", subscriberQueueSize, received)
+	}
+	if !sub.Lagged() {
+		t.Errorf("expected the subscription to be marked as lagged")
+	}
+}
+
+func TestBroker_Close(t *testing.T) {
+	b := &Broker{}
+	before, _, _, _ := b.Subscribe("q", 0)
+	b.Close()
+	after, _, _, _ := b.Subscribe("q", 0)
+	b.Publish("q", &rpc.QueueEvent{})
+
+	for name, sub := range map[string]*Subscription{"before": before, "after": after} {
+		if _, ok := <-sub.C(); ok {
+			t.Errorf("expected the subscription made %v closing to be ended", name)
+		}
+		if sub.Lagged() {
+			t.Errorf("expected the subscription made %v closing not to be marked as lagged", name)
+		}
+	}
+}
+
+func TestBroker_RemoveQueue(t *testing.T) {
+	b := &Broker{}
+	removed, _, _, _ := b.Subscribe("q", 0)
+	other, _, _, _ := b.Subscribe("other", 0)
+	b.RemoveQueue("q")
+
+	if _, ok := <-removed.C(); ok || !removed.Removed() {
+		t.Errorf("expected the removed queue's subscription to be ended")
+	}
+	b.Publish("other", &rpc.QueueEvent{})
+	if _, ok := <-other.C(); !ok || other.Removed() {
+		t.Errorf("expected the other queue's subscription to carry on")
+	}
+}
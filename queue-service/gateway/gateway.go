@@ -0,0 +1,281 @@
+// Package gateway serves the QueueService's RPCs as JSON over HTTP, for callers which can't easily
+// use gRPC.
+//
+// Each RPC is served at POST /v1/<method>, for example /v1/EnqueueItem, and takes its input
+// message as JSON in the request body.  An empty body is taken as an empty message.  Unary RPCs
+// respond with their result message; streaming RPCs respond with a line of JSON for each message,
+// of the form {"result": ...}, or {"error": ...} if the stream fails.  Errors are returned as a
+// google.rpc.Status, with the HTTP status which corresponds to its code.  Messages use the
+// protobuf JSON mapping.
+//
+// The API key is read from the Authorization header, optionally with a "Bearer " prefix, and
+// passed to the service's interceptors just as a gRPC caller's would be.  So is the trace context
+// in the W3C traceparent, tracestate and baggage headers.
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/keys"
+	"github.com/harryrose/godm/queue-service/auth"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// PathPrefix is the path under which the RPCs are served.
+const PathPrefix = "/v1/"
+
+// maxRequestBytes is the largest request body accepted.
+const maxRequestBytes = 1 << 20
+
+var (
+	marshaller   = protojson.MarshalOptions{EmitUnpopulated: true}
+	unmarshaller = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// Handler serves the RPCs of Service over HTTP.  The interceptors are applied to each call, as
+// they are by the gRPC server, and should be the same ones.
+type Handler struct {
+	Service           rpc.QueueServiceServer
+	UnaryInterceptor  grpc.UnaryServerInterceptor
+	StreamInterceptor grpc.StreamServerInterceptor
+	// AllowOrigin, if not empty, is sent as the Access-Control-Allow-Origin header, so that
+	// scripts on other sites can call the gateway from a browser.
+	AllowOrigin string
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.AllowOrigin != "" {
+		w.Header().Set("Access-Control-Allow-Origin", h.AllowOrigin)
+		w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		w.Header().Set("Access-Control-Allow-Methods", "POST")
+	}
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		writeError(w, status.Error(codes.Unimplemented, "only POST is supported"))
+		return
+	}
+	name, ok := strings.CutPrefix(r.URL.Path, PathPrefix)
+	if !ok {
+		writeError(w, status.Error(codes.NotFound, "not found"))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	if err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "unable to read request: %v", err))
+		return
+	}
+	ctx := incomingContext(r)
+
+	for _, m := range rpc.QueueService_ServiceDesc.Methods {
+		if m.MethodName == name {
+			h.serveUnary(ctx, w, m, body)
+			return
+		}
+	}
+	for _, s := range rpc.QueueService_ServiceDesc.Streams {
+		if s.StreamName == name && s.ServerStreams && !s.ClientStreams {
+			h.serveStream(ctx, w, s, body)
+			return
+		}
+	}
+	writeError(w, status.Errorf(codes.NotFound, "unknown method %q", name))
+}
+
+func (h *Handler) serveUnary(ctx context.Context, w http.ResponseWriter, m grpc.MethodDesc, body []byte) {
+	res, err := m.Handler(h.Service, ctx, decoder(body), h.UnaryInterceptor)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	bs, err := marshaller.Marshal(res.(proto.Message))
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "unable to marshal result: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(bs)
+}
+
+func (h *Handler) serveStream(ctx context.Context, w http.ResponseWriter, s grpc.StreamDesc, body []byte) {
+	stream := &httpStream{ctx: ctx, w: w, decode: decoder(body)}
+	var err error
+	if h.StreamInterceptor == nil {
+		err = s.Handler(h.Service, stream)
+	} else {
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + rpc.QueueService_ServiceDesc.ServiceName + "/" + s.StreamName,
+			IsServerStream: true,
+		}
+		err = h.StreamInterceptor(h.Service, stream, info, s.Handler)
+	}
+	if err == nil {
+		return
+	}
+	if !stream.started {
+		// nothing has been sent, so the error can be given its own status
+		writeError(w, err)
+		return
+	}
+	st, _ := status.FromError(err)
+	bs, _ := marshaller.Marshal(st.Proto())
+	stream.writeLine("error", bs)
+}
+
+// propagatedHeaders are passed to the service as metadata, so that calls join the caller's trace.
+var propagatedHeaders = []string{"traceparent", "tracestate", "baggage"}
+
+// incomingContext returns the context a call is made with, holding the caller's key, its trace
+// context and, if it presented a certificate, its TLS state, as the gRPC server would provide
+// them.
+func incomingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if header := r.Header.Get("Authorization"); header != "" {
+		md.Set(auth.AuthorizationKey, strings.TrimPrefix(header, "Bearer "))
+	}
+	for _, header := range propagatedHeaders {
+		if v := r.Header.Get(header); v != "" {
+			md.Set(header, v)
+		}
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	return peer.NewContext(ctx, p)
+}
+
+func decoder(body []byte) func(any) error {
+	return func(m any) error {
+		if len(bytes.TrimSpace(body)) == 0 {
+			return nil
+		}
+		if err := unmarshaller.Unmarshal(body, m.(proto.Message)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "unable to parse request: %v", err)
+		}
+		return nil
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(codes.Unknown, err.Error())
+	}
+	bs, err := marshaller.Marshal(st.Proto())
+	if err != nil {
+		log.Errorw("unable to marshal error", keys.Error, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(HTTPStatus(st.Code()))
+	w.Write(bs)
+}
+
+// HTTPStatus returns the HTTP status which corresponds to a gRPC code.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// httpStream is the server side of a server-streaming call made over HTTP.  The request message
+// is decoded from the body, and each response is written as a line of JSON and flushed.
+type httpStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	decode  func(any) error
+	started bool
+}
+
+func (s *httpStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *httpStream) SetTrailer(metadata.MD) {}
+
+func (s *httpStream) RecvMsg(m any) error {
+	return s.decode(m)
+}
+
+func (s *httpStream) SendMsg(m any) error {
+	bs, err := marshaller.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to marshal result: %v", err)
+	}
+	return s.writeLine("result", bs)
+}
+
+func (s *httpStream) writeLine(field string, msg []byte) error {
+	if !s.started {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.started = true
+	}
+	line, err := json.Marshal(map[string]json.RawMessage{field: msg})
+	if err != nil {
+		return err
+	}
+	if _, err := s.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package gateway
+
+import (
+	"context"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testService struct {
+	rpc.UnimplementedQueueServiceServer
+}
+
+func (testService) ListQueues(ctx context.Context, _ *rpc.ListQueuesInput) (*rpc.ListQueuesResult, error) {
+	return &rpc.ListQueuesResult{Queues: []*rpc.ListQueueResultItem{{Name: "default"}}}, nil
+}
+
+func TestHandler(t *testing.T) {
+	var method string
+	h := &Handler{
+		Service: testService{},
+		UnaryInterceptor: func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			method = info.FullMethod
+			md, _ := metadata.FromIncomingContext(ctx)
+			if v := md.Get("authorization"); len(v) != 1 || v[0] != "secret" {
+				return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+			}
+			return handler(ctx, req)
+		},
+	}
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		auth   string
+		code   int
+		body   string
+	}{
+		{"call", http.MethodPost, "/v1/ListQueues", "Bearer secret", http.StatusOK, `"name":"default"`},
+		{"unauthenticated", http.MethodPost, "/v1/ListQueues", "wrong", http.StatusUnauthorized, `"code":16`},
+		{"unimplemented", http.MethodPost, "/v1/EnqueueItem", "secret", http.StatusNotImplemented, `"code":12`},
+		{"unknown method", http.MethodPost, "/v1/Nope", "secret", http.StatusNotFound, `"code":5`},
+		{"get", http.MethodGet, "/v1/ListQueues", "secret", http.StatusNotImplemented, `"code":12`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.path, strings.NewReader("{}"))
+			r.Header.Set("Authorization", test.auth)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			body := strings.ReplaceAll(w.Body.String(), " ", "")
+			if w.Code != test.code {
+				t.Errorf("expected status %d, got %d: %s", test.code, w.Code, body)
+			}
+			if !strings.Contains(body, test.body) {
+				t.Errorf("expected body to contain %s, got %s", test.body, body)
+			}
+		})
+	}
+	if method != "/queue_svc.QueueService/EnqueueItem" {
+		t.Errorf("expected the interceptor to see the full method name, got %q", method)
+	}
+}
@@ -0,0 +1,68 @@
+package janitor
+
+import (
+	"context"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/keys"
+	"github.com/harryrose/godm/queue-service/db"
+	"time"
+)
+
+// DefaultInterval is how often the janitor prunes the history if no interval is given.
+const DefaultInterval = time.Minute
+
+// Janitor removes the finished items which each queue's history retention policy no longer
+// keeps.
+type Janitor struct {
+	DB db.Store
+	// Interval is how often the history is pruned.  The zero value uses DefaultInterval.
+	Interval time.Duration
+}
+
+// Prune applies the retention policy of every queue once, and returns the number of items
+// removed.  A queue which can't be pruned is logged and skipped, so that the others still are.
+func (j *Janitor) Prune(now time.Time) int {
+	queues, err := j.DB.ListQueues()
+	if err != nil {
+		log.Errorw("unable to list queues to prune", keys.Error, err)
+		return 0
+	}
+	total := 0
+	for _, q := range queues {
+		removed, err := j.DB.PruneHistory(q.Id, now)
+		if err != nil {
+			log.Errorw("unable to prune history", "queue", q.Id, keys.Error, err)
+			continue
+		}
+		if removed > 0 {
+			log.Infow("pruned history", "queue", q.Id, "removed", removed)
+		}
+		total += removed
+	}
+	return total
+}
+
+// PruneLoopAsync prunes the history straight away, then every interval until the context is
+// cancelled.  The returned channel is closed once the loop has stopped.
+func (j *Janitor) PruneLoopAsync(ctx context.Context) <-chan struct{} {
+	interval := j.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer ticker.Stop()
+		j.Prune(time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				j.Prune(now)
+			}
+		}
+	}()
+	return done
+}
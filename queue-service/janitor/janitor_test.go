@@ -0,0 +1,124 @@
+package janitor
+
+import (
+	"context"
+	"errors"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/levels"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/queue"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	if err := log.Init(levels.Error); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// testStore fails to prune one of its queues, and notes each time the queues are listed.
+type testStore struct {
+	db.Store
+	failing string
+	listed  chan struct{}
+}
+
+func (s *testStore) ListQueues() ([]*db.Queue, error) {
+	select {
+	case s.listed <- struct{}{}:
+	default:
+	}
+	return s.Store.ListQueues()
+}
+
+func (s *testStore) PruneHistory(queue string, now time.Time) (int, error) {
+	if queue == s.failing {
+		return 0, errors.New("unable to prune")
+	}
+	return s.Store.PruneHistory(queue, now)
+}
+
+func newTestStore(t *testing.T) *testStore {
+	t.Helper()
+	s := &testStore{Store: db.NewMemory(), failing: "failing", listed: make(chan struct{}, 1)}
+	retention := &db.HistoryRetention{MaxEntries: 1}
+	for name, meta := range map[string]*db.Queue{
+		"pruned":    {HistoryRetention: retention},
+		"unlimited": nil,
+		"failing":   {HistoryRetention: retention},
+	} {
+		if _, err := s.CreateQueue(name, meta); err != nil {
+			t.Fatalf("unable to create queue: %v", err)
+		}
+		for range 3 {
+			completeTestItem(t, s, name)
+		}
+	}
+	return s
+}
+
+func completeTestItem(t *testing.T, s db.Store, q string) {
+	t.Helper()
+	id, err := s.EnqueueItem(q, &db.Item{
+		Source:      &db.Target{Url: "http://example.com"},
+		Destination: &db.Target{Url: "file://example"},
+	})
+	if err != nil {
+		t.Fatalf("unable to enqueue item: %v", err)
+	}
+	claimed, err := s.ClaimNextItem(q, "w")
+	if err != nil || claimed.Id != id {
+		t.Fatalf("unable to claim item: %v, %v", claimed, err)
+	}
+	if _, err := s.SetItemState(id, claimed.Lease, queue.ItemState_ITEM_STATE_COMPLETE, 0, 0, db.FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+		t.Fatalf("unable to complete item: %v", err)
+	}
+}
+
+func TestJanitor_Prune(t *testing.T) {
+	s := newTestStore(t)
+	j := &Janitor{DB: s}
+
+	if removed := j.Prune(time.Now()); removed != 2 {
+		t.Errorf("expected 2 items to be pruned, got %v", removed)
+	}
+	for name, want := range map[string]int{"pruned": 1, "unlimited": 3, "failing": 3} {
+		stats, err := s.QueueStats(name)
+		if err != nil || stats.Finished != want {
+			t.Errorf("%v: expected %d finished items, got %+v, %v", name, want, stats, err)
+		}
+	}
+	if removed := j.Prune(time.Now()); removed != 0 {
+		t.Errorf("expected nothing more to be pruned, got %v", removed)
+	}
+}
+
+func TestJanitor_PruneLoopAsync(t *testing.T) {
+	s := newTestStore(t)
+	j := &Janitor{DB: s, Interval: time.Millisecond}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := j.PruneLoopAsync(ctx)
+	// the history is pruned straight away, and then again after each interval
+	for range 2 {
+		select {
+		case <-s.listed:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected the history to be pruned")
+		}
+	}
+	if stats, err := s.QueueStats("pruned"); err != nil || stats.Finished != 1 {
+		t.Errorf("expected the history to be pruned, got %+v, %v", stats, err)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the loop to stop once the context was cancelled")
+	}
+}
@@ -0,0 +1,74 @@
+package queue_service
+
+import (
+	"context"
+	db2 "github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CreateKey(ctx context.Context, in *rpc.CreateKeyInput) (*rpc.CreateKeyResult, error) {
+	if in == nil || len(in.Name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be provided and non-empty")
+	}
+	if s.Keys == nil {
+		return nil, status.Errorf(codes.Unimplemented, "keys are not enabled")
+	}
+	key, secret, err := s.Keys.Create(in.Name, db2.Role(in.Role))
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.CreateKeyResult{Key: keyToRPC(key), Secret: secret}, nil
+}
+
+func (s *Service) RevokeKey(ctx context.Context, in *rpc.RevokeKeyInput) (*rpc.RevokeKeyResult, error) {
+	if in == nil || len(in.Name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be provided and non-empty")
+	}
+	if s.Keys == nil {
+		return nil, status.Errorf(codes.Unimplemented, "keys are not enabled")
+	}
+	if err := s.Keys.Revoke(in.Name); err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.RevokeKeyResult{}, nil
+}
+
+func (s *Service) RotateKey(ctx context.Context, in *rpc.RotateKeyInput) (*rpc.RotateKeyResult, error) {
+	if in == nil || len(in.Name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be provided and non-empty")
+	}
+	if s.Keys == nil {
+		return nil, status.Errorf(codes.Unimplemented, "keys are not enabled")
+	}
+	key, secret, err := s.Keys.Rotate(in.Name)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.RotateKeyResult{Key: keyToRPC(key), Secret: secret}, nil
+}
+
+func (s *Service) ListKeys(ctx context.Context, in *rpc.ListKeysInput) (*rpc.ListKeysResult, error) {
+	if s.Keys == nil {
+		return nil, status.Errorf(codes.Unimplemented, "keys are not enabled")
+	}
+	keys, err := s.Keys.List()
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	out := &rpc.ListKeysResult{}
+	for _, key := range keys {
+		out.Keys = append(out.Keys, keyToRPC(key))
+	}
+	return out, nil
+}
+
+func keyToRPC(key *db2.ApiKey) *rpc.ApiKey {
+	return &rpc.ApiKey{
+		Name:    key.Name,
+		Role:    rpc.Role(key.Role),
+		Created: key.Created,
+		Rotated: key.Rotated,
+	}
+}
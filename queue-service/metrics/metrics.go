@@ -0,0 +1,109 @@
+// Package metrics publishes the queue service's metrics in the Prometheus format.  The packages
+// which do the work own most of their metrics; this package times RPCs and reports the state of
+// the queues.
+package metrics
+
+import (
+	"context"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/keys"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/queue"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"path"
+	"strings"
+	"time"
+)
+
+var rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "godm",
+	Subsystem: "rpc",
+	Name:      "duration_seconds",
+	Help:      "The time taken to handle RPCs, by method and status code.  Streams are timed until they end.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method", "code"})
+
+// UnaryServerInterceptor times unary RPCs.  It should be the first interceptor, so that calls
+// refused by the others are counted.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		res, err := handler(ctx, req)
+		observeRPC(info.FullMethod, err, start)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor times streaming RPCs.  It should be the first interceptor, so that
+// calls refused by the others are counted.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, err, start)
+		return err
+	}
+}
+
+func observeRPC(fullMethod string, err error, start time.Time) {
+	rpcDuration.WithLabelValues(path.Base(fullMethod), status.Code(err).String()).Observe(time.Since(start).Seconds())
+}
+
+var (
+	activeItemsDesc = prometheus.NewDesc(
+		"godm_queue_active_items",
+		"The number of items in the queue, by state.",
+		[]string{"queue", "state"}, nil,
+	)
+	finishedItemsDesc = prometheus.NewDesc(
+		"godm_queue_finished_items",
+		"The number of items in the queue's history.",
+		[]string{"queue"}, nil,
+	)
+)
+
+// activeStates are the states items in the active queue can be in.  Each is reported, even if no
+// items are in it, so that the series don't disappear when a queue empties.
+var activeStates = []queue.ItemState_State{
+	queue.ItemState_ITEM_STATE_QUEUED,
+	queue.ItemState_ITEM_STATE_DOWNLOADING,
+	queue.ItemState_ITEM_STATE_WAITING,
+	queue.ItemState_ITEM_STATE_BLOCKED,
+}
+
+// QueueCollector reports the number of items in each queue as it is scraped.
+type QueueCollector struct {
+	DB db.Store
+}
+
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeItemsDesc
+	ch <- finishedItemsDesc
+}
+
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	queues, err := c.DB.ListQueues()
+	if err != nil {
+		log.Warnw("unable to list queues for metrics", keys.Error, err)
+		return
+	}
+	for _, q := range queues {
+		stats, err := c.DB.QueueStats(q.Id)
+		if err != nil {
+			log.Warnw("unable to count items for metrics", "queue", q.Id, keys.Error, err)
+			continue
+		}
+		for _, s := range activeStates {
+			ch <- prometheus.MustNewConstMetric(activeItemsDesc, prometheus.GaugeValue, float64(stats.Active[s]), q.Id, stateLabel(s))
+		}
+		ch <- prometheus.MustNewConstMetric(finishedItemsDesc, prometheus.GaugeValue, float64(stats.Finished), q.Id)
+	}
+}
+
+// stateLabel returns the name of the state as it is labelled, such as "downloading".
+func stateLabel(s queue.ItemState_State) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "ITEM_STATE_"))
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"fmt"
+	"github.com/harryrose/godm/log"
+	"github.com/harryrose/godm/log/levels"
+	"github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/queue"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	if err := log.Init(levels.Error); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestQueueCollector(t *testing.T) {
+	store := db.NewMemory()
+	t.Cleanup(func() { store.Close() })
+	for _, name := range []string{"busy", "empty"} {
+		if _, err := store.CreateQueue(name, nil); err != nil {
+			t.Fatalf("unable to create queue %v: %v", name, err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		item := &db.Item{
+			Source:      &db.Target{Url: fmt.Sprintf("http://example.com/%d", i)},
+			Destination: &db.Target{Url: fmt.Sprintf("file://example/%d", i)},
+		}
+		if _, err := store.EnqueueItem("busy", item); err != nil {
+			t.Fatalf("unable to enqueue item: %v", err)
+		}
+	}
+	// one item finishes, one is downloading and one is still queued
+	for i, state := range []queue.ItemState_State{queue.ItemState_ITEM_STATE_COMPLETE, queue.ItemState_ITEM_STATE_DOWNLOADING} {
+		claim, err := store.ClaimNextItem("busy", "worker")
+		if err != nil {
+			t.Fatalf("unable to claim item %d: %v", i, err)
+		}
+		if _, err := store.SetItemState(claim.Id, claim.Lease, state, 10, 10, db.FailureClass_FAILURE_CLASS_UNSPECIFIED, nil); err != nil {
+			t.Fatalf("unable to set the state of item %d: %v", i, err)
+		}
+	}
+
+	expected := `
+# HELP godm_queue_active_items The number of items in the queue, by state.
+# TYPE godm_queue_active_items gauge
+godm_queue_active_items{queue="busy",state="blocked"} 0
+godm_queue_active_items{queue="busy",state="downloading"} 1
+godm_queue_active_items{queue="busy",state="queued"} 1
+godm_queue_active_items{queue="busy",state="waiting"} 0
+godm_queue_active_items{queue="empty",state="blocked"} 0
+godm_queue_active_items{queue="empty",state="downloading"} 0
+godm_queue_active_items{queue="empty",state="queued"} 0
+godm_queue_active_items{queue="empty",state="waiting"} 0
+# HELP godm_queue_finished_items The number of items in the queue's history.
+# TYPE godm_queue_finished_items gauge
+godm_queue_finished_items{queue="busy"} 1
+godm_queue_finished_items{queue="empty"} 0
+`
+	if err := testutil.CollectAndCompare(&QueueCollector{DB: store}, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,103 @@
+package queue_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/harryrose/godm/log"
+	db2 "github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/queue"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DeleteQueue archives the queue to a new file in ArchiveDir, if asked to, before deleting it.
+// If the archive can't be written, the queue isn't deleted.
+func (s *Service) DeleteQueue(ctx context.Context, in *rpc.DeleteQueueInput) (*rpc.DeleteQueueResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	queueID := db2.QueueID(in.Queue.Id)
+
+	out := &rpc.DeleteQueueResult{}
+	var archive io.Writer
+	if in.Archive {
+		if s.ArchiveDir == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "archiving is not enabled")
+		}
+		if err := os.MkdirAll(s.ArchiveDir, 0700); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to create archive directory: %v", err)
+		}
+		path := filepath.Join(s.ArchiveDir, fmt.Sprintf("%s-%s.jsonl", queueID, time.Now().Format("20060102150405")))
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to create archive: %v", err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Errorw("error closing queue archive", "path", path, "error", err)
+			}
+		}()
+		archive = f
+		out.ArchivePath = path
+	}
+
+	if err := s.DB.DeleteQueue(queueID, archive); err != nil {
+		if out.ArchivePath != "" {
+			os.Remove(out.ArchivePath)
+		}
+		return nil, coerceDBError(err)
+	}
+	if s.Events != nil {
+		s.Events.RemoveQueue(queueID)
+	}
+	return out, nil
+}
+
+func (s *Service) RenameQueue(ctx context.Context, in *rpc.RenameQueueInput) (*rpc.RenameQueueResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	if len(in.Name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be provided and non-empty")
+	}
+	if _, err := s.DB.RenameQueue(in.Queue.Id, in.Name); err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.RenameQueueResult{}, nil
+}
+
+func (s *Service) PauseQueue(ctx context.Context, in *rpc.PauseQueueInput) (*rpc.PauseQueueResult, error) {
+	if err := s.setPaused(in.GetQueue(), true); err != nil {
+		return nil, err
+	}
+	return &rpc.PauseQueueResult{}, nil
+}
+
+func (s *Service) ResumeQueue(ctx context.Context, in *rpc.ResumeQueueInput) (*rpc.ResumeQueueResult, error) {
+	if err := s.setPaused(in.GetQueue(), false); err != nil {
+		return nil, err
+	}
+	return &rpc.ResumeQueueResult{}, nil
+}
+
+func (s *Service) setPaused(id *queue.Identifier, paused bool) error {
+	if len(id.GetId()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	_, err := s.DB.UpdateQueue(id.Id, func(meta *db2.Queue) error {
+		meta.Paused = paused
+		return nil
+	})
+	return coerceDBError(err)
+}
@@ -10,6 +10,8 @@ import (
 	queue "github.com/harryrose/godm/queue-service/queue"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,14 +24,496 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type ListQueuesInput struct {
+// Role determines which RPCs an API key may call.
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	// ROLE_READ_ONLY may list and get queues, items, settings and workers.
+	Role_ROLE_READ_ONLY Role = 1
+	// ROLE_CLIENT may also enqueue, cancel and reorder items.
+	Role_ROLE_CLIENT Role = 2
+	// ROLE_WORKER may also register, claim items and set their state.
+	Role_ROLE_WORKER Role = 3
+	// ROLE_ADMIN may call every RPC.
+	Role_ROLE_ADMIN Role = 4
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_READ_ONLY",
+		2: "ROLE_CLIENT",
+		3: "ROLE_WORKER",
+		4: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_READ_ONLY":   1,
+		"ROLE_CLIENT":      2,
+		"ROLE_WORKER":      3,
+		"ROLE_ADMIN":       4,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{0}
+}
+
+type MoveItemInput_Placement int32
+
+const (
+	MoveItemInput_PLACEMENT_UNSPECIFIED MoveItemInput_Placement = 0
+	MoveItemInput_PLACEMENT_BEFORE      MoveItemInput_Placement = 1
+	MoveItemInput_PLACEMENT_AFTER       MoveItemInput_Placement = 2
+)
+
+// Enum value maps for MoveItemInput_Placement.
+var (
+	MoveItemInput_Placement_name = map[int32]string{
+		0: "PLACEMENT_UNSPECIFIED",
+		1: "PLACEMENT_BEFORE",
+		2: "PLACEMENT_AFTER",
+	}
+	MoveItemInput_Placement_value = map[string]int32{
+		"PLACEMENT_UNSPECIFIED": 0,
+		"PLACEMENT_BEFORE":      1,
+		"PLACEMENT_AFTER":       2,
+	}
+)
+
+func (x MoveItemInput_Placement) Enum() *MoveItemInput_Placement {
+	p := new(MoveItemInput_Placement)
+	*p = x
+	return p
+}
+
+func (x MoveItemInput_Placement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoveItemInput_Placement) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[1].Descriptor()
+}
+
+func (MoveItemInput_Placement) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[1]
+}
+
+func (x MoveItemInput_Placement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoveItemInput_Placement.Descriptor instead.
+func (MoveItemInput_Placement) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type QueueEvent_Type int32
+
+const (
+	QueueEvent_QUEUE_EVENT_TYPE_UNSPECIFIED QueueEvent_Type = 0
+	// SNAPSHOT events report an item that was in the queue when the watch began
+	QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT QueueEvent_Type = 1
+	// SNAPSHOT_COMPLETE marks the end of the snapshot.  It carries no item.
+	QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE QueueEvent_Type = 2
+	QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED          QueueEvent_Type = 3
+	QueueEvent_QUEUE_EVENT_TYPE_CLAIMED           QueueEvent_Type = 4
+	QueueEvent_QUEUE_EVENT_TYPE_UPDATED           QueueEvent_Type = 5
+	QueueEvent_QUEUE_EVENT_TYPE_CANCELLED         QueueEvent_Type = 6
+	// FINISHED events are sent when an item is moved out of the active queue and
+	// into the queue's history.
+	QueueEvent_QUEUE_EVENT_TYPE_FINISHED QueueEvent_Type = 7
+	// MOVED events are sent when an item's position in the queue changes.
+	QueueEvent_QUEUE_EVENT_TYPE_MOVED QueueEvent_Type = 8
+)
+
+// Enum value maps for QueueEvent_Type.
+var (
+	QueueEvent_Type_name = map[int32]string{
+		0: "QUEUE_EVENT_TYPE_UNSPECIFIED",
+		1: "QUEUE_EVENT_TYPE_SNAPSHOT",
+		2: "QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE",
+		3: "QUEUE_EVENT_TYPE_ENQUEUED",
+		4: "QUEUE_EVENT_TYPE_CLAIMED",
+		5: "QUEUE_EVENT_TYPE_UPDATED",
+		6: "QUEUE_EVENT_TYPE_CANCELLED",
+		7: "QUEUE_EVENT_TYPE_FINISHED",
+		8: "QUEUE_EVENT_TYPE_MOVED",
+	}
+	QueueEvent_Type_value = map[string]int32{
+		"QUEUE_EVENT_TYPE_UNSPECIFIED":       0,
+		"QUEUE_EVENT_TYPE_SNAPSHOT":          1,
+		"QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE": 2,
+		"QUEUE_EVENT_TYPE_ENQUEUED":          3,
+		"QUEUE_EVENT_TYPE_CLAIMED":           4,
+		"QUEUE_EVENT_TYPE_UPDATED":           5,
+		"QUEUE_EVENT_TYPE_CANCELLED":         6,
+		"QUEUE_EVENT_TYPE_FINISHED":          7,
+		"QUEUE_EVENT_TYPE_MOVED":             8,
+	}
+)
+
+func (x QueueEvent_Type) Enum() *QueueEvent_Type {
+	p := new(QueueEvent_Type)
+	*p = x
+	return p
+}
+
+func (x QueueEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[2].Descriptor()
+}
+
+func (QueueEvent_Type) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[2]
+}
+
+func (x QueueEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueEvent_Type.Descriptor instead.
+func (QueueEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type ItemSort_Field int32
+
+const (
+	// SORT_FIELD_DEFAULT is the natural order of the listing
+	ItemSort_SORT_FIELD_DEFAULT     ItemSort_Field = 0
+	ItemSort_SORT_FIELD_UPDATED     ItemSort_Field = 1
+	ItemSort_SORT_FIELD_SOURCE      ItemSort_Field = 2
+	ItemSort_SORT_FIELD_DESTINATION ItemSort_Field = 3
+	ItemSort_SORT_FIELD_CATEGORY    ItemSort_Field = 4
+	ItemSort_SORT_FIELD_TOTAL_SIZE  ItemSort_Field = 5
+)
+
+// Enum value maps for ItemSort_Field.
+var (
+	ItemSort_Field_name = map[int32]string{
+		0: "SORT_FIELD_DEFAULT",
+		1: "SORT_FIELD_UPDATED",
+		2: "SORT_FIELD_SOURCE",
+		3: "SORT_FIELD_DESTINATION",
+		4: "SORT_FIELD_CATEGORY",
+		5: "SORT_FIELD_TOTAL_SIZE",
+	}
+	ItemSort_Field_value = map[string]int32{
+		"SORT_FIELD_DEFAULT":     0,
+		"SORT_FIELD_UPDATED":     1,
+		"SORT_FIELD_SOURCE":      2,
+		"SORT_FIELD_DESTINATION": 3,
+		"SORT_FIELD_CATEGORY":    4,
+		"SORT_FIELD_TOTAL_SIZE":  5,
+	}
+)
+
+func (x ItemSort_Field) Enum() *ItemSort_Field {
+	p := new(ItemSort_Field)
+	*p = x
+	return p
+}
+
+func (x ItemSort_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemSort_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ItemSort_Field) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[3]
+}
+
+func (x ItemSort_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemSort_Field.Descriptor instead.
+func (ItemSort_Field) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type EnqueueItemInput_DependencyPolicy int32
+
+const (
+	// UNSPECIFIED is treated as FAIL
+	EnqueueItemInput_DEPENDENCY_POLICY_UNSPECIFIED EnqueueItemInput_DependencyPolicy = 0
+	// FAIL fails the item if any item it depends on fails or is cancelled.
+	EnqueueItemInput_DEPENDENCY_POLICY_FAIL EnqueueItemInput_DependencyPolicy = 1
+	// HOLD leaves the item blocked in the queue if any item it depends on fails or is
+	// cancelled, until it is cancelled itself.
+	EnqueueItemInput_DEPENDENCY_POLICY_HOLD EnqueueItemInput_DependencyPolicy = 2
+)
+
+// Enum value maps for EnqueueItemInput_DependencyPolicy.
+var (
+	EnqueueItemInput_DependencyPolicy_name = map[int32]string{
+		0: "DEPENDENCY_POLICY_UNSPECIFIED",
+		1: "DEPENDENCY_POLICY_FAIL",
+		2: "DEPENDENCY_POLICY_HOLD",
+	}
+	EnqueueItemInput_DependencyPolicy_value = map[string]int32{
+		"DEPENDENCY_POLICY_UNSPECIFIED": 0,
+		"DEPENDENCY_POLICY_FAIL":        1,
+		"DEPENDENCY_POLICY_HOLD":        2,
+	}
+)
+
+func (x EnqueueItemInput_DependencyPolicy) Enum() *EnqueueItemInput_DependencyPolicy {
+	p := new(EnqueueItemInput_DependencyPolicy)
+	*p = x
+	return p
+}
+
+func (x EnqueueItemInput_DependencyPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnqueueItemInput_DependencyPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[4].Descriptor()
+}
+
+func (EnqueueItemInput_DependencyPolicy) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[4]
+}
+
+func (x EnqueueItemInput_DependencyPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnqueueItemInput_DependencyPolicy.Descriptor instead.
+func (EnqueueItemInput_DependencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{46, 0}
+}
+
+type EnqueueItemInput_DuplicatePolicy int32
+
+const (
+	// UNSPECIFIED is treated as ALLOW
+	EnqueueItemInput_DUPLICATE_POLICY_UNSPECIFIED EnqueueItemInput_DuplicatePolicy = 0
+	// ALLOW enqueues the item even if it duplicates another.
+	EnqueueItemInput_DUPLICATE_POLICY_ALLOW EnqueueItemInput_DuplicatePolicy = 1
+	// REJECT fails with ALREADY_EXISTS if the item duplicates another.  The error's details
+	// include a google.rpc.ResourceInfo whose resource_name is the id of the existing item.
+	EnqueueItemInput_DUPLICATE_POLICY_REJECT EnqueueItemInput_DuplicatePolicy = 2
+	// RETURN_EXISTING returns the id of the existing item, rather than enqueueing the item,
+	// if the item duplicates another.
+	EnqueueItemInput_DUPLICATE_POLICY_RETURN_EXISTING EnqueueItemInput_DuplicatePolicy = 3
+)
+
+// Enum value maps for EnqueueItemInput_DuplicatePolicy.
+var (
+	EnqueueItemInput_DuplicatePolicy_name = map[int32]string{
+		0: "DUPLICATE_POLICY_UNSPECIFIED",
+		1: "DUPLICATE_POLICY_ALLOW",
+		2: "DUPLICATE_POLICY_REJECT",
+		3: "DUPLICATE_POLICY_RETURN_EXISTING",
+	}
+	EnqueueItemInput_DuplicatePolicy_value = map[string]int32{
+		"DUPLICATE_POLICY_UNSPECIFIED":     0,
+		"DUPLICATE_POLICY_ALLOW":           1,
+		"DUPLICATE_POLICY_REJECT":          2,
+		"DUPLICATE_POLICY_RETURN_EXISTING": 3,
+	}
+)
+
+func (x EnqueueItemInput_DuplicatePolicy) Enum() *EnqueueItemInput_DuplicatePolicy {
+	p := new(EnqueueItemInput_DuplicatePolicy)
+	*p = x
+	return p
+}
+
+func (x EnqueueItemInput_DuplicatePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnqueueItemInput_DuplicatePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[5].Descriptor()
+}
+
+func (EnqueueItemInput_DuplicatePolicy) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[5]
+}
+
+func (x EnqueueItemInput_DuplicatePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnqueueItemInput_DuplicatePolicy.Descriptor instead.
+func (EnqueueItemInput_DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{46, 1}
+}
+
+type EnqueueItemInput_DuplicateMatch int32
+
+const (
+	// UNSPECIFIED is treated as SOURCE
+	EnqueueItemInput_DUPLICATE_MATCH_UNSPECIFIED EnqueueItemInput_DuplicateMatch = 0
+	// SOURCE matches items downloaded from the same url.
+	EnqueueItemInput_DUPLICATE_MATCH_SOURCE EnqueueItemInput_DuplicateMatch = 1
+	// DESTINATION matches items saved to the same destination.
+	EnqueueItemInput_DUPLICATE_MATCH_DESTINATION EnqueueItemInput_DuplicateMatch = 2
+	// SOURCE_AND_DESTINATION matches items with the same source and destination.
+	EnqueueItemInput_DUPLICATE_MATCH_SOURCE_AND_DESTINATION EnqueueItemInput_DuplicateMatch = 3
+)
+
+// Enum value maps for EnqueueItemInput_DuplicateMatch.
+var (
+	EnqueueItemInput_DuplicateMatch_name = map[int32]string{
+		0: "DUPLICATE_MATCH_UNSPECIFIED",
+		1: "DUPLICATE_MATCH_SOURCE",
+		2: "DUPLICATE_MATCH_DESTINATION",
+		3: "DUPLICATE_MATCH_SOURCE_AND_DESTINATION",
+	}
+	EnqueueItemInput_DuplicateMatch_value = map[string]int32{
+		"DUPLICATE_MATCH_UNSPECIFIED":            0,
+		"DUPLICATE_MATCH_SOURCE":                 1,
+		"DUPLICATE_MATCH_DESTINATION":            2,
+		"DUPLICATE_MATCH_SOURCE_AND_DESTINATION": 3,
+	}
+)
+
+func (x EnqueueItemInput_DuplicateMatch) Enum() *EnqueueItemInput_DuplicateMatch {
+	p := new(EnqueueItemInput_DuplicateMatch)
+	*p = x
+	return p
+}
+
+func (x EnqueueItemInput_DuplicateMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnqueueItemInput_DuplicateMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[6].Descriptor()
+}
+
+func (EnqueueItemInput_DuplicateMatch) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[6]
+}
+
+func (x EnqueueItemInput_DuplicateMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnqueueItemInput_DuplicateMatch.Descriptor instead.
+func (EnqueueItemInput_DuplicateMatch) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{46, 2}
+}
+
+type BackupInput_Format int32
+
+const (
+	// FORMAT_UNSPECIFIED is treated as FORMAT_SNAPSHOT.
+	BackupInput_FORMAT_UNSPECIFIED BackupInput_Format = 0
+	// FORMAT_SNAPSHOT is a copy of the database file, which can be restored in place of it.
+	// It is only available if the service's store is held in a file.
+	BackupInput_FORMAT_SNAPSHOT BackupInput_Format = 1
+	// FORMAT_JSONL is an export of every queue, with its items and history, as lines of
+	// JSON.  It can be imported into a store of any kind.
+	BackupInput_FORMAT_JSONL BackupInput_Format = 2
+)
+
+// Enum value maps for BackupInput_Format.
+var (
+	BackupInput_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_SNAPSHOT",
+		2: "FORMAT_JSONL",
+	}
+	BackupInput_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_SNAPSHOT":    1,
+		"FORMAT_JSONL":       2,
+	}
+)
+
+func (x BackupInput_Format) Enum() *BackupInput_Format {
+	p := new(BackupInput_Format)
+	*p = x
+	return p
+}
+
+func (x BackupInput_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupInput_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_service_proto_enumTypes[7].Descriptor()
+}
+
+func (BackupInput_Format) Type() protoreflect.EnumType {
+	return &file_queue_service_proto_enumTypes[7]
+}
+
+func (x BackupInput_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupInput_Format.Descriptor instead.
+func (BackupInput_Format) EnumDescriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{58, 0}
+}
+
+// QueueConfig holds the settings of a queue.
+type QueueConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ListQueuesInput) Reset() {
-	*x = ListQueuesInput{}
+	// claim_ttl is how long a claim on an item lasts without a progress report before the
+	// item can be claimed again.  Defaults to 30 seconds, and must be at least 10 seconds.
+	ClaimTtl *durationpb.Duration `protobuf:"bytes,1,opt,name=claim_ttl,json=claimTtl,proto3" json:"claim_ttl,omitempty"`
+	// max_concurrent_claims is the maximum number of the queue's items which can be claimed
+	// at once, across all downloaders.  Zero means there is no limit.
+	MaxConcurrentClaims uint32 `protobuf:"varint,2,opt,name=max_concurrent_claims,json=maxConcurrentClaims,proto3" json:"max_concurrent_claims,omitempty"`
+	// default_category is given to items enqueued without a category.
+	DefaultCategory string `protobuf:"bytes,3,opt,name=default_category,json=defaultCategory,proto3" json:"default_category,omitempty"`
+	// default_destination_prefix is prepended to the path of items enqueued with a relative
+	// file destination, such as file://name.iso.  Absolute destinations are left as they are.
+	DefaultDestinationPrefix string `protobuf:"bytes,4,opt,name=default_destination_prefix,json=defaultDestinationPrefix,proto3" json:"default_destination_prefix,omitempty"`
+	// default_rate_limit_bytes_per_second is the download rate which downloaders use for the
+	// queue's items, in place of their own setting.  Zero leaves the rate to the downloader.
+	DefaultRateLimitBytesPerSecond uint64 `protobuf:"varint,5,opt,name=default_rate_limit_bytes_per_second,json=defaultRateLimitBytesPerSecond,proto3" json:"default_rate_limit_bytes_per_second,omitempty"`
+	// retry_policy is applied to the queue's items which don't specify their own.
+	RetryPolicy *queue.RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// history_retention determines how long the queue's finished items are kept.  If unset,
+	// they are kept until the history is cleared.
+	HistoryRetention *HistoryRetention `protobuf:"bytes,7,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+}
+
+func (x *QueueConfig) Reset() {
+	*x = QueueConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -37,13 +521,13 @@ func (x *ListQueuesInput) Reset() {
 	}
 }
 
-func (x *ListQueuesInput) String() string {
+func (x *QueueConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesInput) ProtoMessage() {}
+func (*QueueConfig) ProtoMessage() {}
 
-func (x *ListQueuesInput) ProtoReflect() protoreflect.Message {
+func (x *QueueConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -55,22 +539,81 @@ func (x *ListQueuesInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesInput.ProtoReflect.Descriptor instead.
-func (*ListQueuesInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueConfig.ProtoReflect.Descriptor instead.
+func (*QueueConfig) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{0}
 }
 
-type ListQueueResultItem struct {
+func (x *QueueConfig) GetClaimTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ClaimTtl
+	}
+	return nil
+}
+
+func (x *QueueConfig) GetMaxConcurrentClaims() uint32 {
+	if x != nil {
+		return x.MaxConcurrentClaims
+	}
+	return 0
+}
+
+func (x *QueueConfig) GetDefaultCategory() string {
+	if x != nil {
+		return x.DefaultCategory
+	}
+	return ""
+}
+
+func (x *QueueConfig) GetDefaultDestinationPrefix() string {
+	if x != nil {
+		return x.DefaultDestinationPrefix
+	}
+	return ""
+}
+
+func (x *QueueConfig) GetDefaultRateLimitBytesPerSecond() uint64 {
+	if x != nil {
+		return x.DefaultRateLimitBytesPerSecond
+	}
+	return 0
+}
+
+func (x *QueueConfig) GetRetryPolicy() *queue.RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *QueueConfig) GetHistoryRetention() *HistoryRetention {
+	if x != nil {
+		return x.HistoryRetention
+	}
+	return nil
+}
+
+// HistoryRetention determines which of a queue's finished items are kept.  The service
+// periodically removes the items which any of the rules no longer keeps.  Unset or zero fields
+// don't limit the history.  Failed items are always kept for at least as long as the others.
+type HistoryRetention struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the name of the queue
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// max_entries is the most completed and cancelled items kept.  The oldest are removed first.
+	// Failed items don't count towards it, and are only removed by age.
+	MaxEntries uint32 `protobuf:"varint,1,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// max_age is how long items are kept after they finish.
+	MaxAge *durationpb.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// failed_max_age replaces max_age for items which failed, so that failures can be kept for
+	// longer than successes.  If unset, max_age applies to them too.  It must be no shorter than
+	// max_age, and can only be set with it.
+	FailedMaxAge *durationpb.Duration `protobuf:"bytes,3,opt,name=failed_max_age,json=failedMaxAge,proto3" json:"failed_max_age,omitempty"`
 }
 
-func (x *ListQueueResultItem) Reset() {
-	*x = ListQueueResultItem{}
+func (x *HistoryRetention) Reset() {
+	*x = HistoryRetention{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -78,13 +621,13 @@ func (x *ListQueueResultItem) Reset() {
 	}
 }
 
-func (x *ListQueueResultItem) String() string {
+func (x *HistoryRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueueResultItem) ProtoMessage() {}
+func (*HistoryRetention) ProtoMessage() {}
 
-func (x *ListQueueResultItem) ProtoReflect() protoreflect.Message {
+func (x *HistoryRetention) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -96,29 +639,43 @@ func (x *ListQueueResultItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueueResultItem.ProtoReflect.Descriptor instead.
-func (*ListQueueResultItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryRetention.ProtoReflect.Descriptor instead.
+func (*HistoryRetention) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListQueueResultItem) GetName() string {
+func (x *HistoryRetention) GetMaxEntries() uint32 {
 	if x != nil {
-		return x.Name
+		return x.MaxEntries
 	}
-	return ""
+	return 0
 }
 
-type ListQueuesResult struct {
+func (x *HistoryRetention) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *HistoryRetention) GetFailedMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.FailedMaxAge
+	}
+	return nil
+}
+
+// GetQueueConfigInput is the input to GetQueueConfig
+type GetQueueConfigInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// queues is the list of queues known to the system
-	Queues []*ListQueueResultItem `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 }
 
-func (x *ListQueuesResult) Reset() {
-	*x = ListQueuesResult{}
+func (x *GetQueueConfigInput) Reset() {
+	*x = GetQueueConfigInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -126,13 +683,13 @@ func (x *ListQueuesResult) Reset() {
 	}
 }
 
-func (x *ListQueuesResult) String() string {
+func (x *GetQueueConfigInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResult) ProtoMessage() {}
+func (*GetQueueConfigInput) ProtoMessage() {}
 
-func (x *ListQueuesResult) ProtoReflect() protoreflect.Message {
+func (x *GetQueueConfigInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -144,29 +701,29 @@ func (x *ListQueuesResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResult.ProtoReflect.Descriptor instead.
-func (*ListQueuesResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetQueueConfigInput.ProtoReflect.Descriptor instead.
+func (*GetQueueConfigInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListQueuesResult) GetQueues() []*ListQueueResultItem {
+func (x *GetQueueConfigInput) GetQueue() *queue.Identifier {
 	if x != nil {
-		return x.Queues
+		return x.Queue
 	}
 	return nil
 }
 
-type ClearHistoryInput struct {
+// GetQueueConfigResult is the response from GetQueueConfig
+type GetQueueConfigResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// queue is the identifier of the queue whose history should be cleared
-	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Config *QueueConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *ClearHistoryInput) Reset() {
-	*x = ClearHistoryInput{}
+func (x *GetQueueConfigResult) Reset() {
+	*x = GetQueueConfigResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,13 +731,13 @@ func (x *ClearHistoryInput) Reset() {
 	}
 }
 
-func (x *ClearHistoryInput) String() string {
+func (x *GetQueueConfigResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearHistoryInput) ProtoMessage() {}
+func (*GetQueueConfigResult) ProtoMessage() {}
 
-func (x *ClearHistoryInput) ProtoReflect() protoreflect.Message {
+func (x *GetQueueConfigResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -192,26 +749,34 @@ func (x *ClearHistoryInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearHistoryInput.ProtoReflect.Descriptor instead.
-func (*ClearHistoryInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetQueueConfigResult.ProtoReflect.Descriptor instead.
+func (*GetQueueConfigResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ClearHistoryInput) GetQueue() *queue.Identifier {
+func (x *GetQueueConfigResult) GetConfig() *QueueConfig {
 	if x != nil {
-		return x.Queue
+		return x.Config
 	}
 	return nil
 }
 
-type ClearHistoryResult struct {
+// UpdateQueueConfigInput is the input to UpdateQueueConfig
+type UpdateQueueConfigInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// config holds the new settings
+	Config *QueueConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// update_mask lists the fields of config to apply, such as "claim_ttl".  If empty, every
+	// field is applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *ClearHistoryResult) Reset() {
-	*x = ClearHistoryResult{}
+func (x *UpdateQueueConfigInput) Reset() {
+	*x = UpdateQueueConfigInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,13 +784,13 @@ func (x *ClearHistoryResult) Reset() {
 	}
 }
 
-func (x *ClearHistoryResult) String() string {
+func (x *UpdateQueueConfigInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearHistoryResult) ProtoMessage() {}
+func (*UpdateQueueConfigInput) ProtoMessage() {}
 
-func (x *ClearHistoryResult) ProtoReflect() protoreflect.Message {
+func (x *UpdateQueueConfigInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -237,24 +802,44 @@ func (x *ClearHistoryResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearHistoryResult.ProtoReflect.Descriptor instead.
-func (*ClearHistoryResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateQueueConfigInput.ProtoReflect.Descriptor instead.
+func (*UpdateQueueConfigInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{4}
 }
 
-type GetFinishedItemsInput struct {
+func (x *UpdateQueueConfigInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *UpdateQueueConfigInput) GetConfig() *QueueConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *UpdateQueueConfigInput) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateQueueConfigResult is the response from UpdateQueueConfig
+type UpdateQueueConfigResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// queue is the identifier of the queue whose items should be returned
-	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	// pagination specifies parameters required for paginating results
-	Pagination *PaginationParameters `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// config holds the queue's settings after the update
+	Config *QueueConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *GetFinishedItemsInput) Reset() {
-	*x = GetFinishedItemsInput{}
+func (x *UpdateQueueConfigResult) Reset() {
+	*x = UpdateQueueConfigResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,13 +847,13 @@ func (x *GetFinishedItemsInput) Reset() {
 	}
 }
 
-func (x *GetFinishedItemsInput) String() string {
+func (x *UpdateQueueConfigResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFinishedItemsInput) ProtoMessage() {}
+func (*UpdateQueueConfigResult) ProtoMessage() {}
 
-func (x *GetFinishedItemsInput) ProtoReflect() protoreflect.Message {
+func (x *UpdateQueueConfigResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,38 +865,32 @@ func (x *GetFinishedItemsInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFinishedItemsInput.ProtoReflect.Descriptor instead.
-func (*GetFinishedItemsInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateQueueConfigResult.ProtoReflect.Descriptor instead.
+func (*UpdateQueueConfigResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetFinishedItemsInput) GetQueue() *queue.Identifier {
-	if x != nil {
-		return x.Queue
-	}
-	return nil
-}
-
-func (x *GetFinishedItemsInput) GetPagination() *PaginationParameters {
+func (x *UpdateQueueConfigResult) GetConfig() *QueueConfig {
 	if x != nil {
-		return x.Pagination
+		return x.Config
 	}
 	return nil
 }
 
-type GetFinishedItemsResult struct {
+// SetQueueRetryPolicyInput is the input to SetQueueRetryPolicy
+type SetQueueRetryPolicyInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination specifies the parameters that should be used in the next call to GetQueueItems
-	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
-	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// queue is the identifier of the queue whose policy is to be set
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// retry_policy is the new policy.  If unset, failed items in the queue are not retried.
+	RetryPolicy *queue.RetryPolicy `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 }
 
-func (x *GetFinishedItemsResult) Reset() {
-	*x = GetFinishedItemsResult{}
+func (x *SetQueueRetryPolicyInput) Reset() {
+	*x = SetQueueRetryPolicyInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,13 +898,13 @@ func (x *GetFinishedItemsResult) Reset() {
 	}
 }
 
-func (x *GetFinishedItemsResult) String() string {
+func (x *SetQueueRetryPolicyInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFinishedItemsResult) ProtoMessage() {}
+func (*SetQueueRetryPolicyInput) ProtoMessage() {}
 
-func (x *GetFinishedItemsResult) ProtoReflect() protoreflect.Message {
+func (x *SetQueueRetryPolicyInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -337,35 +916,34 @@ func (x *GetFinishedItemsResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFinishedItemsResult.ProtoReflect.Descriptor instead.
-func (*GetFinishedItemsResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetQueueRetryPolicyInput.ProtoReflect.Descriptor instead.
+func (*SetQueueRetryPolicyInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetFinishedItemsResult) GetPagination() *PaginationParameters {
+func (x *SetQueueRetryPolicyInput) GetQueue() *queue.Identifier {
 	if x != nil {
-		return x.Pagination
+		return x.Queue
 	}
 	return nil
 }
 
-func (x *GetFinishedItemsResult) GetItems() []*IdentifiedQueueItemWithState {
+func (x *SetQueueRetryPolicyInput) GetRetryPolicy() *queue.RetryPolicy {
 	if x != nil {
-		return x.Items
+		return x.RetryPolicy
 	}
 	return nil
 }
 
-type ClaimNextItemInput struct {
+// SetQueueRetryPolicyResult is the response from SetQueueRetryPolicy
+type SetQueueRetryPolicyResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
 }
 
-func (x *ClaimNextItemInput) Reset() {
-	*x = ClaimNextItemInput{}
+func (x *SetQueueRetryPolicyResult) Reset() {
+	*x = SetQueueRetryPolicyResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,13 +951,13 @@ func (x *ClaimNextItemInput) Reset() {
 	}
 }
 
-func (x *ClaimNextItemInput) String() string {
+func (x *SetQueueRetryPolicyResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimNextItemInput) ProtoMessage() {}
+func (*SetQueueRetryPolicyResult) ProtoMessage() {}
 
-func (x *ClaimNextItemInput) ProtoReflect() protoreflect.Message {
+func (x *SetQueueRetryPolicyResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,29 +969,27 @@ func (x *ClaimNextItemInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimNextItemInput.ProtoReflect.Descriptor instead.
-func (*ClaimNextItemInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetQueueRetryPolicyResult.ProtoReflect.Descriptor instead.
+func (*SetQueueRetryPolicyResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ClaimNextItemInput) GetQueue() *queue.Identifier {
-	if x != nil {
-		return x.Queue
-	}
-	return nil
-}
-
-type ClaimNextItemResult struct {
+// MoveItemInput is the input to MoveItem
+type MoveItemInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Item *queue.Item       `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// item is the identifier of the item to be moved
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// relative_to is the identifier of the item next to which the item should be placed
+	RelativeTo *queue.Identifier `protobuf:"bytes,2,opt,name=relative_to,json=relativeTo,proto3" json:"relative_to,omitempty"`
+	// placement indicates which side of relative_to the item should be placed
+	Placement MoveItemInput_Placement `protobuf:"varint,3,opt,name=placement,proto3,enum=queue_svc.MoveItemInput_Placement" json:"placement,omitempty"`
 }
 
-func (x *ClaimNextItemResult) Reset() {
-	*x = ClaimNextItemResult{}
+func (x *MoveItemInput) Reset() {
+	*x = MoveItemInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,13 +997,13 @@ func (x *ClaimNextItemResult) Reset() {
 	}
 }
 
-func (x *ClaimNextItemResult) String() string {
+func (x *MoveItemInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimNextItemResult) ProtoMessage() {}
+func (*MoveItemInput) ProtoMessage() {}
 
-func (x *ClaimNextItemResult) ProtoReflect() protoreflect.Message {
+func (x *MoveItemInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,40 +1015,41 @@ func (x *ClaimNextItemResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimNextItemResult.ProtoReflect.Descriptor instead.
-func (*ClaimNextItemResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveItemInput.ProtoReflect.Descriptor instead.
+func (*MoveItemInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ClaimNextItemResult) GetId() *queue.Identifier {
+func (x *MoveItemInput) GetItem() *queue.Identifier {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
 	return nil
 }
 
-func (x *ClaimNextItemResult) GetItem() *queue.Item {
+func (x *MoveItemInput) GetRelativeTo() *queue.Identifier {
 	if x != nil {
-		return x.Item
+		return x.RelativeTo
 	}
 	return nil
 }
 
-// SetItemStateInput is the parameters passed into SetItemState
-type SetItemStateInput struct {
+func (x *MoveItemInput) GetPlacement() MoveItemInput_Placement {
+	if x != nil {
+		return x.Placement
+	}
+	return MoveItemInput_PLACEMENT_UNSPECIFIED
+}
+
+// MoveItemResult is the response from MoveItem
+type MoveItemResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// item is the identifier of item whose state is to be updated
-	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
-	// state is the new value of the state. Zero values and unspecified values
-	// are ignored.
-	State *queue.ItemState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *SetItemStateInput) Reset() {
-	*x = SetItemStateInput{}
+func (x *MoveItemResult) Reset() {
+	*x = MoveItemResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,13 +1057,13 @@ func (x *SetItemStateInput) Reset() {
 	}
 }
 
-func (x *SetItemStateInput) String() string {
+func (x *MoveItemResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetItemStateInput) ProtoMessage() {}
+func (*MoveItemResult) ProtoMessage() {}
 
-func (x *SetItemStateInput) ProtoReflect() protoreflect.Message {
+func (x *MoveItemResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,39 +1075,23 @@ func (x *SetItemStateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetItemStateInput.ProtoReflect.Descriptor instead.
-func (*SetItemStateInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveItemResult.ProtoReflect.Descriptor instead.
+func (*MoveItemResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SetItemStateInput) GetItem() *queue.Identifier {
-	if x != nil {
-		return x.Item
-	}
-	return nil
-}
-
-func (x *SetItemStateInput) GetState() *queue.ItemState {
-	if x != nil {
-		return x.State
-	}
-	return nil
-}
-
-// SetItemStateResult is the result of the SetItemState call.
-type SetItemStateResult struct {
+// MoveToFrontInput is the input to MoveToFront
+type MoveToFrontInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination specifies the parameters that should be used in the next call to GetQueueItems
-	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
-	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// item is the identifier of the item to be moved
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *SetItemStateResult) Reset() {
-	*x = SetItemStateResult{}
+func (x *MoveToFrontInput) Reset() {
+	*x = MoveToFrontInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -538,13 +1099,13 @@ func (x *SetItemStateResult) Reset() {
 	}
 }
 
-func (x *SetItemStateResult) String() string {
+func (x *MoveToFrontInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetItemStateResult) ProtoMessage() {}
+func (*MoveToFrontInput) ProtoMessage() {}
 
-func (x *SetItemStateResult) ProtoReflect() protoreflect.Message {
+func (x *MoveToFrontInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,39 +1117,27 @@ func (x *SetItemStateResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetItemStateResult.ProtoReflect.Descriptor instead.
-func (*SetItemStateResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveToFrontInput.ProtoReflect.Descriptor instead.
+func (*MoveToFrontInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SetItemStateResult) GetPagination() *PaginationParameters {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *SetItemStateResult) GetItems() []*IdentifiedQueueItemWithState {
+func (x *MoveToFrontInput) GetItem() *queue.Identifier {
 	if x != nil {
-		return x.Items
+		return x.Item
 	}
 	return nil
 }
 
-// GetQueueItemsInput is the parameters passed into GetQueueItems
-type GetQueueItemsInput struct {
+// MoveToFrontResult is the response from MoveToFront
+type MoveToFrontResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// queue is the identifier of the queue whose items should be returned
-	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	// pagination specifies parameters required for paginating results
-	Pagination *PaginationParameters `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *GetQueueItemsInput) Reset() {
-	*x = GetQueueItemsInput{}
+func (x *MoveToFrontResult) Reset() {
+	*x = MoveToFrontResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,13 +1145,13 @@ func (x *GetQueueItemsInput) Reset() {
 	}
 }
 
-func (x *GetQueueItemsInput) String() string {
+func (x *MoveToFrontResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQueueItemsInput) ProtoMessage() {}
+func (*MoveToFrontResult) ProtoMessage() {}
 
-func (x *GetQueueItemsInput) ProtoReflect() protoreflect.Message {
+func (x *MoveToFrontResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,39 +1163,23 @@ func (x *GetQueueItemsInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQueueItemsInput.ProtoReflect.Descriptor instead.
-func (*GetQueueItemsInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveToFrontResult.ProtoReflect.Descriptor instead.
+func (*MoveToFrontResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetQueueItemsInput) GetQueue() *queue.Identifier {
-	if x != nil {
-		return x.Queue
-	}
-	return nil
-}
-
-func (x *GetQueueItemsInput) GetPagination() *PaginationParameters {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-// GetQueueItemsResult is the response from GetQueueItems
-type GetQueueItemsResult struct {
+// MoveToBackInput is the input to MoveToBack
+type MoveToBackInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// pagination specifies the parameters that should be used in the next call to GetQueueItems
-	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
-	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// item is the identifier of the item to be moved
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *GetQueueItemsResult) Reset() {
-	*x = GetQueueItemsResult{}
+func (x *MoveToBackInput) Reset() {
+	*x = MoveToBackInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,13 +1187,13 @@ func (x *GetQueueItemsResult) Reset() {
 	}
 }
 
-func (x *GetQueueItemsResult) String() string {
+func (x *MoveToBackInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQueueItemsResult) ProtoMessage() {}
+func (*MoveToBackInput) ProtoMessage() {}
 
-func (x *GetQueueItemsResult) ProtoReflect() protoreflect.Message {
+func (x *MoveToBackInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,37 +1205,27 @@ func (x *GetQueueItemsResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQueueItemsResult.ProtoReflect.Descriptor instead.
-func (*GetQueueItemsResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveToBackInput.ProtoReflect.Descriptor instead.
+func (*MoveToBackInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetQueueItemsResult) GetPagination() *PaginationParameters {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-func (x *GetQueueItemsResult) GetItems() []*IdentifiedQueueItemWithState {
+func (x *MoveToBackInput) GetItem() *queue.Identifier {
 	if x != nil {
-		return x.Items
+		return x.Item
 	}
 	return nil
 }
 
-// CancelItemInput is the input to CancelItem
-type CancelItemInput struct {
+// MoveToBackResult is the response from MoveToBack
+type MoveToBackResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// item is the identifier of the item to be cancelled
-	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *CancelItemInput) Reset() {
-	*x = CancelItemInput{}
+func (x *MoveToBackResult) Reset() {
+	*x = MoveToBackResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,13 +1233,13 @@ func (x *CancelItemInput) Reset() {
 	}
 }
 
-func (x *CancelItemInput) String() string {
+func (x *MoveToBackResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelItemInput) ProtoMessage() {}
+func (*MoveToBackResult) ProtoMessage() {}
 
-func (x *CancelItemInput) ProtoReflect() protoreflect.Message {
+func (x *MoveToBackResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,27 +1251,33 @@ func (x *CancelItemInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelItemInput.ProtoReflect.Descriptor instead.
-func (*CancelItemInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveToBackResult.ProtoReflect.Descriptor instead.
+func (*MoveToBackResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CancelItemInput) GetItem() *queue.Identifier {
-	if x != nil {
-		return x.Item
-	}
-	return nil
-}
-
-// CancelItemResult is the response from CancelItem
-type CancelItemResult struct {
+// UpdateItemInput is the input to UpdateItem
+type UpdateItemInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *CancelItemResult) Reset() {
-	*x = CancelItemResult{}
+	// item is the identifier of the item to be updated
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// changes holds the item's new values.  Only its source, destination and category can
+	// be changed.
+	Changes *queue.Item `protobuf:"bytes,2,opt,name=changes,proto3" json:"changes,omitempty"`
+	// update_mask lists the fields of changes to apply: "source", "destination" or
+	// "category".  If empty, all three are applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// next_attempt allows the source of an item which is being downloaded to be changed.
+	// The current attempt carries on from the old source, and the new one is used if the
+	// item is attempted again.  Only the source can be changed this way.
+	NextAttempt bool `protobuf:"varint,4,opt,name=next_attempt,json=nextAttempt,proto3" json:"next_attempt,omitempty"`
+}
+
+func (x *UpdateItemInput) Reset() {
+	*x = UpdateItemInput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -756,13 +1285,13 @@ func (x *CancelItemResult) Reset() {
 	}
 }
 
-func (x *CancelItemResult) String() string {
+func (x *UpdateItemInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelItemResult) ProtoMessage() {}
+func (*UpdateItemInput) ProtoMessage() {}
 
-func (x *CancelItemResult) ProtoReflect() protoreflect.Message {
+func (x *UpdateItemInput) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,30 +1303,51 @@ func (x *CancelItemResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelItemResult.ProtoReflect.Descriptor instead.
-func (*CancelItemResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateItemInput.ProtoReflect.Descriptor instead.
+func (*UpdateItemInput) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{14}
 }
 
-// IdentifiedQueueItemWithState is a pair of an IdentifiedQueueItem and the ItemState describing the current
-// progress being made on that item
-type IdentifiedQueueItemWithState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UpdateItemInput) GetItem() *queue.Identifier {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
 
-	// id is the identifier of the item whose state is being reported
-	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// item is the item whose state is being reported
-	Item *queue.Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	// state is the current download progress of the item
-	State *queue.ItemState `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	// updated is the last time the state was updated
-	Updated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated,proto3" json:"updated,omitempty"`
+func (x *UpdateItemInput) GetChanges() *queue.Item {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
 }
 
-func (x *IdentifiedQueueItemWithState) Reset() {
-	*x = IdentifiedQueueItemWithState{}
+func (x *UpdateItemInput) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateItemInput) GetNextAttempt() bool {
+	if x != nil {
+		return x.NextAttempt
+	}
+	return false
+}
+
+// UpdateItemResult is the response from UpdateItem
+type UpdateItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// item is the item after the update
+	Item *IdentifiedQueueItemWithState `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateItemResult) Reset() {
+	*x = UpdateItemResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_queue_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,13 +1355,13 @@ func (x *IdentifiedQueueItemWithState) Reset() {
 	}
 }
 
-func (x *IdentifiedQueueItemWithState) String() string {
+func (x *UpdateItemResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IdentifiedQueueItemWithState) ProtoMessage() {}
+func (*UpdateItemResult) ProtoMessage() {}
 
-func (x *IdentifiedQueueItemWithState) ProtoReflect() protoreflect.Message {
+func (x *UpdateItemResult) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,67 +1373,227 @@ func (x *IdentifiedQueueItemWithState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IdentifiedQueueItemWithState.ProtoReflect.Descriptor instead.
-func (*IdentifiedQueueItemWithState) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateItemResult.ProtoReflect.Descriptor instead.
+func (*UpdateItemResult) Descriptor() ([]byte, []int) {
 	return file_queue_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *IdentifiedQueueItemWithState) GetId() *queue.Identifier {
+func (x *UpdateItemResult) GetItem() *IdentifiedQueueItemWithState {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
 	return nil
 }
 
-func (x *IdentifiedQueueItemWithState) GetItem() *queue.Item {
+// WatchQueueInput is the input to WatchQueue
+type WatchQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue to watch
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// resume_from is the sequence number of the last event received by the caller.  If
+	// the events which follow it are still held by the service, they are replayed and
+	// no snapshot is sent.  Otherwise, or if zero, the stream begins with a snapshot.
+	ResumeFrom uint64 `protobuf:"varint,2,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+}
+
+func (x *WatchQueueInput) Reset() {
+	*x = WatchQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQueueInput) ProtoMessage() {}
+
+func (x *WatchQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQueueInput.ProtoReflect.Descriptor instead.
+func (*WatchQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchQueueInput) GetQueue() *queue.Identifier {
 	if x != nil {
-		return x.Item
+		return x.Queue
 	}
 	return nil
 }
 
-func (x *IdentifiedQueueItemWithState) GetState() *queue.ItemState {
+func (x *WatchQueueInput) GetResumeFrom() uint64 {
 	if x != nil {
-		return x.State
+		return x.ResumeFrom
+	}
+	return 0
+}
+
+// QueueEvent describes a change to an item in a queue
+type QueueEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sequence increases by one with each event published for a queue.  Snapshot events
+	// carry the sequence number of the last event that the snapshot reflects.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// type is the kind of change being reported
+	Type QueueEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=queue_svc.QueueEvent_Type" json:"type,omitempty"`
+	// item is the item that changed.  For UPDATED, CANCELLED and FINISHED events only the
+	// id and state are populated.  The order of the queue following a MOVED event can be
+	// found by calling GetQueueItems.
+	Item *IdentifiedQueueItemWithState `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	// timestamp is the time at which the change was published
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *QueueEvent) Reset() {
+	*x = QueueEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEvent) ProtoMessage() {}
+
+func (x *QueueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEvent.ProtoReflect.Descriptor instead.
+func (*QueueEvent) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueueEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *QueueEvent) GetType() QueueEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return QueueEvent_QUEUE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *QueueEvent) GetItem() *IdentifiedQueueItemWithState {
+	if x != nil {
+		return x.Item
 	}
 	return nil
 }
 
-func (x *IdentifiedQueueItemWithState) GetUpdated() *timestamppb.Timestamp {
+func (x *QueueEvent) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Updated
+		return x.Timestamp
 	}
 	return nil
 }
 
-// EnqueueItemInput is the input to EnqueueItem
-type EnqueueItemInput struct {
+type ListQueuesInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	// item defines the item to be added to the download queue
-	Item *queue.Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+func (x *ListQueuesInput) Reset() {
+	*x = ListQueuesInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *EnqueueItemInput) Reset() {
-	*x = EnqueueItemInput{}
+func (x *ListQueuesInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesInput) ProtoMessage() {}
+
+func (x *ListQueuesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesInput.ProtoReflect.Descriptor instead.
+func (*ListQueuesInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{18}
+}
+
+type ListQueueResultItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the queue
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// id identifies the queue in calls to the service
+	Id *queue.Identifier `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// created is the time at which the queue was created
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	// paused is true if the queue's items aren't being claimed
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (x *ListQueueResultItem) Reset() {
+	*x = ListQueueResultItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_queue_service_proto_msgTypes[16]
+		mi := &file_queue_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnqueueItemInput) String() string {
+func (x *ListQueueResultItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnqueueItemInput) ProtoMessage() {}
+func (*ListQueueResultItem) ProtoMessage() {}
 
-func (x *EnqueueItemInput) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_service_proto_msgTypes[16]
+func (x *ListQueueResultItem) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,52 +1604,65 @@ func (x *EnqueueItemInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnqueueItemInput.ProtoReflect.Descriptor instead.
-func (*EnqueueItemInput) Descriptor() ([]byte, []int) {
-	return file_queue_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListQueueResultItem.ProtoReflect.Descriptor instead.
+func (*ListQueueResultItem) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EnqueueItemInput) GetQueue() *queue.Identifier {
+func (x *ListQueueResultItem) GetName() string {
 	if x != nil {
-		return x.Queue
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListQueueResultItem) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *EnqueueItemInput) GetItem() *queue.Item {
+func (x *ListQueueResultItem) GetCreated() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Item
+		return x.Created
 	}
 	return nil
 }
 
-// EnqueueItemResult is the response from EnqueueItem
-type EnqueueItemResult struct {
+func (x *ListQueueResultItem) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type ListQueuesResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the identifier for the enqueued item
-	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// queues is the list of queues known to the system
+	Queues []*ListQueueResultItem `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
 }
 
-func (x *EnqueueItemResult) Reset() {
-	*x = EnqueueItemResult{}
+func (x *ListQueuesResult) Reset() {
+	*x = ListQueuesResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_queue_service_proto_msgTypes[17]
+		mi := &file_queue_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnqueueItemResult) String() string {
+func (x *ListQueuesResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnqueueItemResult) ProtoMessage() {}
+func (*ListQueuesResult) ProtoMessage() {}
 
-func (x *EnqueueItemResult) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_service_proto_msgTypes[17]
+func (x *ListQueuesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,444 +1673,5185 @@ func (x *EnqueueItemResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnqueueItemResult.ProtoReflect.Descriptor instead.
-func (*EnqueueItemResult) Descriptor() ([]byte, []int) {
-	return file_queue_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListQueuesResult.ProtoReflect.Descriptor instead.
+func (*ListQueuesResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EnqueueItemResult) GetId() *queue.Identifier {
+func (x *ListQueuesResult) GetQueues() []*ListQueueResultItem {
 	if x != nil {
-		return x.Id
+		return x.Queues
 	}
 	return nil
 }
 
-// CreateQueueInput is the input to CreateQueue
-type CreateQueueInput struct {
+type DeleteQueueInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name is the human-readable name to give to the queue.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// queue is the identifier of the queue to delete
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// archive the queue, with its items and history, before deleting it
+	Archive bool `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
 }
 
-func (x *CreateQueueInput) Reset() {
-	*x = CreateQueueInput{}
+func (x *DeleteQueueInput) Reset() {
+	*x = DeleteQueueInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_queue_service_proto_msgTypes[18]
+		mi := &file_queue_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateQueueInput) String() string {
+func (x *DeleteQueueInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQueueInput) ProtoMessage() {}
+func (*DeleteQueueInput) ProtoMessage() {}
 
-func (x *CreateQueueInput) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_service_proto_msgTypes[18]
+func (x *DeleteQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateQueueInput.ProtoReflect.Descriptor instead.
-func (*CreateQueueInput) Descriptor() ([]byte, []int) {
-	return file_queue_service_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *CreateQueueInput) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-// CreateQueueResult is the response from CreateQueue
-type CreateQueueResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// id is the identifier for the newly created queue
-	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *CreateQueueResult) Reset() {
-	*x = CreateQueueResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_queue_service_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateQueueResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateQueueResult) ProtoMessage() {}
-
-func (x *CreateQueueResult) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_service_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueInput.ProtoReflect.Descriptor instead.
+func (*DeleteQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteQueueInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *DeleteQueueInput) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+type DeleteQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// archive_path is the path, on the service's host, of the queue's archive, if one was
+	// written
+	ArchivePath string `protobuf:"bytes,1,opt,name=archive_path,json=archivePath,proto3" json:"archive_path,omitempty"`
+}
+
+func (x *DeleteQueueResult) Reset() {
+	*x = DeleteQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQueueResult) ProtoMessage() {}
+
+func (x *DeleteQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQueueResult.ProtoReflect.Descriptor instead.
+func (*DeleteQueueResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteQueueResult) GetArchivePath() string {
+	if x != nil {
+		return x.ArchivePath
+	}
+	return ""
+}
+
+type RenameQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue to rename
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// name is the queue's new name, which no other queue may have
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RenameQueueInput) Reset() {
+	*x = RenameQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameQueueInput) ProtoMessage() {}
+
+func (x *RenameQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameQueueInput.ProtoReflect.Descriptor instead.
+func (*RenameQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RenameQueueInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *RenameQueueInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RenameQueueResult) Reset() {
+	*x = RenameQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameQueueResult) ProtoMessage() {}
+
+func (x *RenameQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameQueueResult.ProtoReflect.Descriptor instead.
+func (*RenameQueueResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{24}
+}
+
+type PauseQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue to pause
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (x *PauseQueueInput) Reset() {
+	*x = PauseQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseQueueInput) ProtoMessage() {}
+
+func (x *PauseQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseQueueInput.ProtoReflect.Descriptor instead.
+func (*PauseQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PauseQueueInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type PauseQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PauseQueueResult) Reset() {
+	*x = PauseQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseQueueResult) ProtoMessage() {}
+
+func (x *PauseQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseQueueResult.ProtoReflect.Descriptor instead.
+func (*PauseQueueResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{26}
+}
+
+type ResumeQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue to resume
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (x *ResumeQueueInput) Reset() {
+	*x = ResumeQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeQueueInput) ProtoMessage() {}
+
+func (x *ResumeQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeQueueInput.ProtoReflect.Descriptor instead.
+func (*ResumeQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ResumeQueueInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type ResumeQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResumeQueueResult) Reset() {
+	*x = ResumeQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeQueueResult) ProtoMessage() {}
+
+func (x *ResumeQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeQueueResult.ProtoReflect.Descriptor instead.
+func (*ResumeQueueResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{28}
+}
+
+type ClearHistoryInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue whose history should be cleared
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// filter restricts the items removed to those which match it, such as failed items which
+	// finished before a given time.  If unset, the whole history is removed.
+	Filter *ItemFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ClearHistoryInput) Reset() {
+	*x = ClearHistoryInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearHistoryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryInput) ProtoMessage() {}
+
+func (x *ClearHistoryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryInput.ProtoReflect.Descriptor instead.
+func (*ClearHistoryInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ClearHistoryInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *ClearHistoryInput) GetFilter() *ItemFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ClearHistoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed is the number of finished items removed
+	Removed uint32 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ClearHistoryResult) Reset() {
+	*x = ClearHistoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryResult) ProtoMessage() {}
+
+func (x *ClearHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryResult.ProtoReflect.Descriptor instead.
+func (*ClearHistoryResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClearHistoryResult) GetRemoved() uint32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// RequeueItemsInput is the input to RequeueItems
+type RequeueItemsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue whose finished items should be requeued
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// items lists the ids of the finished items to requeue
+	Items []*queue.Identifier `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// filter selects the finished items to requeue if items is empty, such as every failed
+	// item in a category.  An empty filter selects the whole history.
+	Filter *ItemFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *RequeueItemsInput) Reset() {
+	*x = RequeueItemsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueItemsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueItemsInput) ProtoMessage() {}
+
+func (x *RequeueItemsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueItemsInput.ProtoReflect.Descriptor instead.
+func (*RequeueItemsInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RequeueItemsInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *RequeueItemsInput) GetItems() []*queue.Identifier {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RequeueItemsInput) GetFilter() *ItemFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// RequeueItemsResult is the response from RequeueItems
+type RequeueItemsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// items lists the new items, in the order in which they were enqueued
+	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RequeueItemsResult) Reset() {
+	*x = RequeueItemsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequeueItemsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueItemsResult) ProtoMessage() {}
+
+func (x *RequeueItemsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueItemsResult.ProtoReflect.Descriptor instead.
+func (*RequeueItemsResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequeueItemsResult) GetItems() []*IdentifiedQueueItemWithState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetFinishedItemsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue whose items should be returned
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// pagination specifies parameters required for paginating results
+	Pagination *PaginationParameters `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// filter restricts the results to items matching the filter
+	Filter *ItemFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// sort determines the order of the results.  By default, items are returned in the
+	// order in which they finished, oldest first.
+	Sort *ItemSort `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *GetFinishedItemsInput) Reset() {
+	*x = GetFinishedItemsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinishedItemsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinishedItemsInput) ProtoMessage() {}
+
+func (x *GetFinishedItemsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinishedItemsInput.ProtoReflect.Descriptor instead.
+func (*GetFinishedItemsInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetFinishedItemsInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *GetFinishedItemsInput) GetPagination() *PaginationParameters {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFinishedItemsInput) GetFilter() *ItemFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetFinishedItemsInput) GetSort() *ItemSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type GetFinishedItemsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination specifies the parameters that should be used in the next call to GetQueueItems
+	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
+	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetFinishedItemsResult) Reset() {
+	*x = GetFinishedItemsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinishedItemsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinishedItemsResult) ProtoMessage() {}
+
+func (x *GetFinishedItemsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinishedItemsResult.ProtoReflect.Descriptor instead.
+func (*GetFinishedItemsResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetFinishedItemsResult) GetPagination() *PaginationParameters {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFinishedItemsResult) GetItems() []*IdentifiedQueueItemWithState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ClaimNextItemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// worker_id is the id returned by RegisterWorker, recorded as the holder of the claim.
+	// It may be empty for downloaders which haven't registered.
+	WorkerId string `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (x *ClaimNextItemInput) Reset() {
+	*x = ClaimNextItemInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimNextItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimNextItemInput) ProtoMessage() {}
+
+func (x *ClaimNextItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimNextItemInput.ProtoReflect.Descriptor instead.
+func (*ClaimNextItemInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClaimNextItemInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *ClaimNextItemInput) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+type ClaimNextItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Item *queue.Item       `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// rate_limit_bytes_per_second is the rate at which the item should be downloaded, or zero
+	// if the downloader should use its own setting.  It is the rate limit of the item's
+	// category, if it has one, and otherwise the queue's default.
+	RateLimitBytesPerSecond uint64 `protobuf:"varint,3,opt,name=rate_limit_bytes_per_second,json=rateLimitBytesPerSecond,proto3" json:"rate_limit_bytes_per_second,omitempty"`
+	// claim_ttl is how long the claim lasts without a progress report.
+	ClaimTtl *durationpb.Duration `protobuf:"bytes,4,opt,name=claim_ttl,json=claimTtl,proto3" json:"claim_ttl,omitempty"`
+	// lease_token identifies the claim, and must be passed to SetItemState.  Each claim is
+	// issued a larger token than the claims before it in the same queue.
+	LeaseToken uint64 `protobuf:"varint,5,opt,name=lease_token,json=leaseToken,proto3" json:"lease_token,omitempty"`
+	// trace_parent is the W3C traceparent of the call which enqueued the item, if it was traced,
+	// so that the spans of its download can be linked to it.
+	TraceParent string `protobuf:"bytes,6,opt,name=trace_parent,json=traceParent,proto3" json:"trace_parent,omitempty"`
+	// resume_from_bytes is how much of the item an earlier attempt downloaded.  If the
+	// destination still holds that much and the source can start part way through, the
+	// downloader may carry on from there rather than starting again.
+	ResumeFromBytes uint64 `protobuf:"varint,7,opt,name=resume_from_bytes,json=resumeFromBytes,proto3" json:"resume_from_bytes,omitempty"`
+	// category holds the settings of the item's category, if it has been created.  The
+	// downloader places the item under the category's destination directory, and refuses it
+	// if its source, or a redirect from it, isn't one of the category's allowed hosts.
+	Category *CategoryConfig `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *ClaimNextItemResult) Reset() {
+	*x = ClaimNextItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimNextItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimNextItemResult) ProtoMessage() {}
+
+func (x *ClaimNextItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimNextItemResult.ProtoReflect.Descriptor instead.
+func (*ClaimNextItemResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClaimNextItemResult) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ClaimNextItemResult) GetItem() *queue.Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ClaimNextItemResult) GetRateLimitBytesPerSecond() uint64 {
+	if x != nil {
+		return x.RateLimitBytesPerSecond
+	}
+	return 0
+}
+
+func (x *ClaimNextItemResult) GetClaimTtl() *durationpb.Duration {
+	if x != nil {
+		return x.ClaimTtl
+	}
+	return nil
+}
+
+func (x *ClaimNextItemResult) GetLeaseToken() uint64 {
+	if x != nil {
+		return x.LeaseToken
+	}
+	return 0
+}
+
+func (x *ClaimNextItemResult) GetTraceParent() string {
+	if x != nil {
+		return x.TraceParent
+	}
+	return ""
+}
+
+func (x *ClaimNextItemResult) GetResumeFromBytes() uint64 {
+	if x != nil {
+		return x.ResumeFromBytes
+	}
+	return 0
+}
+
+func (x *ClaimNextItemResult) GetCategory() *CategoryConfig {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// SetItemStateInput is the parameters passed into SetItemState
+type SetItemStateInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// item is the identifier of item whose state is to be updated
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// state is the new value of the state. Zero values and unspecified values
+	// are ignored.
+	State *queue.ItemState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// lease_token is the token returned by ClaimNextItem with the claim on the item.  If the
+	// item has since been claimed again, or its failure has already been reported, the update
+	// is rejected with FAILED_PRECONDITION and the caller should stop working on the item.
+	LeaseToken uint64 `protobuf:"varint,3,opt,name=lease_token,json=leaseToken,proto3" json:"lease_token,omitempty"`
+}
+
+func (x *SetItemStateInput) Reset() {
+	*x = SetItemStateInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetItemStateInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemStateInput) ProtoMessage() {}
+
+func (x *SetItemStateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemStateInput.ProtoReflect.Descriptor instead.
+func (*SetItemStateInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetItemStateInput) GetItem() *queue.Identifier {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SetItemStateInput) GetState() *queue.ItemState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *SetItemStateInput) GetLeaseToken() uint64 {
+	if x != nil {
+		return x.LeaseToken
+	}
+	return 0
+}
+
+// SetItemStateResult is the result of the SetItemState call.
+type SetItemStateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination specifies the parameters that should be used in the next call to GetQueueItems
+	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
+	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SetItemStateResult) Reset() {
+	*x = SetItemStateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetItemStateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemStateResult) ProtoMessage() {}
+
+func (x *SetItemStateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemStateResult.ProtoReflect.Descriptor instead.
+func (*SetItemStateResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetItemStateResult) GetPagination() *PaginationParameters {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SetItemStateResult) GetItems() []*IdentifiedQueueItemWithState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetQueueItemsInput is the parameters passed into GetQueueItems
+type GetQueueItemsInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// queue is the identifier of the queue whose items should be returned
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// pagination specifies parameters required for paginating results
+	Pagination *PaginationParameters `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// filter restricts the results to items matching the filter
+	Filter *ItemFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// sort determines the order of the results.  By default, items are returned in the
+	// order in which they are to be downloaded.
+	Sort *ItemSort `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *GetQueueItemsInput) Reset() {
+	*x = GetQueueItemsInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueItemsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueItemsInput) ProtoMessage() {}
+
+func (x *GetQueueItemsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueItemsInput.ProtoReflect.Descriptor instead.
+func (*GetQueueItemsInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetQueueItemsInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *GetQueueItemsInput) GetPagination() *PaginationParameters {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetQueueItemsInput) GetFilter() *ItemFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetQueueItemsInput) GetSort() *ItemSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// ItemFilter restricts a listing of items to those which match all of the specified
+// criteria.  Unset fields match every item.
+type ItemFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// states matches items in any of the given states
+	States []queue.ItemState_State `protobuf:"varint,1,rep,packed,name=states,proto3,enum=queue.ItemState_State" json:"states,omitempty"`
+	// category matches items in the given category
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// source_host matches items whose source url has the given host.  The comparison is
+	// case-insensitive and ignores the port.
+	SourceHost string `protobuf:"bytes,3,opt,name=source_host,json=sourceHost,proto3" json:"source_host,omitempty"`
+	// destination_prefix matches items whose destination url begins with the given string
+	DestinationPrefix string `protobuf:"bytes,4,opt,name=destination_prefix,json=destinationPrefix,proto3" json:"destination_prefix,omitempty"`
+	// updated_before matches items which were last updated before the given time
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// updated_after matches items which were last updated at or after the given time
+	UpdatedAfter *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+}
+
+func (x *ItemFilter) Reset() {
+	*x = ItemFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemFilter) ProtoMessage() {}
+
+func (x *ItemFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemFilter.ProtoReflect.Descriptor instead.
+func (*ItemFilter) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ItemFilter) GetStates() []queue.ItemState_State {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ItemFilter) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ItemFilter) GetSourceHost() string {
+	if x != nil {
+		return x.SourceHost
+	}
+	return ""
+}
+
+func (x *ItemFilter) GetDestinationPrefix() string {
+	if x != nil {
+		return x.DestinationPrefix
+	}
+	return ""
+}
+
+func (x *ItemFilter) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *ItemFilter) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+// ItemSort defines the order in which items are listed
+type ItemSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// field is the field to order the items by
+	Field ItemSort_Field `protobuf:"varint,1,opt,name=field,proto3,enum=queue_svc.ItemSort_Field" json:"field,omitempty"`
+	// descending reverses the order
+	Descending bool `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *ItemSort) Reset() {
+	*x = ItemSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSort) ProtoMessage() {}
+
+func (x *ItemSort) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSort.ProtoReflect.Descriptor instead.
+func (*ItemSort) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ItemSort) GetField() ItemSort_Field {
+	if x != nil {
+		return x.Field
+	}
+	return ItemSort_SORT_FIELD_DEFAULT
+}
+
+func (x *ItemSort) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+// GetQueueItemsResult is the response from GetQueueItems
+type GetQueueItemsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pagination specifies the parameters that should be used in the next call to GetQueueItems
+	Pagination *PaginationParameters `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// items is the download-ordered list of items in the queue. the first item is the first to be downloaded.
+	Items []*IdentifiedQueueItemWithState `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetQueueItemsResult) Reset() {
+	*x = GetQueueItemsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQueueItemsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueItemsResult) ProtoMessage() {}
+
+func (x *GetQueueItemsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueItemsResult.ProtoReflect.Descriptor instead.
+func (*GetQueueItemsResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetQueueItemsResult) GetPagination() *PaginationParameters {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetQueueItemsResult) GetItems() []*IdentifiedQueueItemWithState {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// CancelItemInput is the input to CancelItem
+type CancelItemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// item is the identifier of the item to be cancelled
+	Item *queue.Identifier `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CancelItemInput) Reset() {
+	*x = CancelItemInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelItemInput) ProtoMessage() {}
+
+func (x *CancelItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelItemInput.ProtoReflect.Descriptor instead.
+func (*CancelItemInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CancelItemInput) GetItem() *queue.Identifier {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// CancelItemResult is the response from CancelItem
+type CancelItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelItemResult) Reset() {
+	*x = CancelItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelItemResult) ProtoMessage() {}
+
+func (x *CancelItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelItemResult.ProtoReflect.Descriptor instead.
+func (*CancelItemResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{44}
+}
+
+// IdentifiedQueueItemWithState is a pair of an IdentifiedQueueItem and the ItemState describing the current
+// progress being made on that item
+type IdentifiedQueueItemWithState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the identifier of the item whose state is being reported
+	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// item is the item whose state is being reported
+	Item *queue.Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// state is the current download progress of the item
+	State *queue.ItemState `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// updated is the last time the state was updated
+	Updated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	// attempts lists the item's previous failed attempts, oldest first
+	Attempts []*queue.Attempt `protobuf:"bytes,5,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// not_before is the earliest time at which the item can next be claimed, if any
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// previous_run is the identifier of the item whose completion enqueued this one, if it is
+	// a repeat of a recurring item.  The previous run can be found in the queue's history.
+	PreviousRun *queue.Identifier `protobuf:"bytes,7,opt,name=previous_run,json=previousRun,proto3" json:"previous_run,omitempty"`
+	// depends_on lists the items which had to complete before this item could be claimed.
+	DependsOn []*queue.Identifier `protobuf:"bytes,8,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// claimed_by is the id of the worker which holds the item's claim, if it is downloading.
+	ClaimedBy string `protobuf:"bytes,9,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	// trace_id is the id of the trace of the call which enqueued the item, if it was traced.
+	TraceId string `protobuf:"bytes,10,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// next_source is the source the item's next attempt is downloaded from, if it was changed
+	// while the item was downloading.
+	NextSource *queue.Target `protobuf:"bytes,11,opt,name=next_source,json=nextSource,proto3" json:"next_source,omitempty"`
+	// requeued_from is the identifier of the finished item this item is a copy of, if it was
+	// requeued from the queue's history.
+	RequeuedFrom *queue.Identifier `protobuf:"bytes,12,opt,name=requeued_from,json=requeuedFrom,proto3" json:"requeued_from,omitempty"`
+}
+
+func (x *IdentifiedQueueItemWithState) Reset() {
+	*x = IdentifiedQueueItemWithState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentifiedQueueItemWithState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentifiedQueueItemWithState) ProtoMessage() {}
+
+func (x *IdentifiedQueueItemWithState) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentifiedQueueItemWithState.ProtoReflect.Descriptor instead.
+func (*IdentifiedQueueItemWithState) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IdentifiedQueueItemWithState) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetItem() *queue.Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetState() *queue.ItemState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetAttempts() []*queue.Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetPreviousRun() *queue.Identifier {
+	if x != nil {
+		return x.PreviousRun
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetDependsOn() []*queue.Identifier {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *IdentifiedQueueItemWithState) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *IdentifiedQueueItemWithState) GetNextSource() *queue.Target {
+	if x != nil {
+		return x.NextSource
+	}
+	return nil
+}
+
+func (x *IdentifiedQueueItemWithState) GetRequeuedFrom() *queue.Identifier {
+	if x != nil {
+		return x.RequeuedFrom
+	}
+	return nil
+}
+
+// EnqueueItemInput is the input to EnqueueItem
+type EnqueueItemInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue *queue.Identifier `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	// item defines the item to be added to the download queue
+	Item *queue.Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// depends_on lists items in the same queue which must complete before this item can be
+	// claimed.  Until then, the item is reported as blocked.
+	DependsOn []*queue.Identifier `protobuf:"bytes,3,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	// dependency_policy determines what happens to the item if an item it depends on fails.
+	DependencyPolicy EnqueueItemInput_DependencyPolicy `protobuf:"varint,4,opt,name=dependency_policy,json=dependencyPolicy,proto3,enum=queue_svc.EnqueueItemInput_DependencyPolicy" json:"dependency_policy,omitempty"`
+	// duplicate_policy determines what happens if the item duplicates one already in the queue.
+	DuplicatePolicy EnqueueItemInput_DuplicatePolicy `protobuf:"varint,5,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=queue_svc.EnqueueItemInput_DuplicatePolicy" json:"duplicate_policy,omitempty"`
+	// duplicate_match determines which items the item duplicates.  Urls are compared once they
+	// have been normalised, so that, for example, the case of the host and the order of the query
+	// parameters don't matter.  Destinations are compared after the queue's destination prefix
+	// has been applied.  Sources match the source set for a queued item's next attempt, too.
+	DuplicateMatch EnqueueItemInput_DuplicateMatch `protobuf:"varint,6,opt,name=duplicate_match,json=duplicateMatch,proto3,enum=queue_svc.EnqueueItemInput_DuplicateMatch" json:"duplicate_match,omitempty"`
+	// duplicate_history extends the check to the items which completed among the newest 1000
+	// items in the queue's history, as well as those in the queue.
+	DuplicateHistory bool `protobuf:"varint,7,opt,name=duplicate_history,json=duplicateHistory,proto3" json:"duplicate_history,omitempty"`
+}
+
+func (x *EnqueueItemInput) Reset() {
+	*x = EnqueueItemInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueItemInput) ProtoMessage() {}
+
+func (x *EnqueueItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueItemInput.ProtoReflect.Descriptor instead.
+func (*EnqueueItemInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EnqueueItemInput) GetQueue() *queue.Identifier {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *EnqueueItemInput) GetItem() *queue.Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *EnqueueItemInput) GetDependsOn() []*queue.Identifier {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *EnqueueItemInput) GetDependencyPolicy() EnqueueItemInput_DependencyPolicy {
+	if x != nil {
+		return x.DependencyPolicy
+	}
+	return EnqueueItemInput_DEPENDENCY_POLICY_UNSPECIFIED
+}
+
+func (x *EnqueueItemInput) GetDuplicatePolicy() EnqueueItemInput_DuplicatePolicy {
+	if x != nil {
+		return x.DuplicatePolicy
+	}
+	return EnqueueItemInput_DUPLICATE_POLICY_UNSPECIFIED
+}
+
+func (x *EnqueueItemInput) GetDuplicateMatch() EnqueueItemInput_DuplicateMatch {
+	if x != nil {
+		return x.DuplicateMatch
+	}
+	return EnqueueItemInput_DUPLICATE_MATCH_UNSPECIFIED
+}
+
+func (x *EnqueueItemInput) GetDuplicateHistory() bool {
+	if x != nil {
+		return x.DuplicateHistory
+	}
+	return false
+}
+
+// EnqueueItemResult is the response from EnqueueItem
+type EnqueueItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the identifier for the enqueued item
+	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// duplicate is true if the item wasn't enqueued because it duplicates the item with the
+	// returned id, which is either queued or, if duplicate_history was set, completed.
+	Duplicate bool `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *EnqueueItemResult) Reset() {
+	*x = EnqueueItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnqueueItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueItemResult) ProtoMessage() {}
+
+func (x *EnqueueItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueItemResult.ProtoReflect.Descriptor instead.
+func (*EnqueueItemResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EnqueueItemResult) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *EnqueueItemResult) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// CreateQueueInput is the input to CreateQueue
+type CreateQueueInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the human-readable name to give to the queue.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// retry_policy is applied to the queue's items which don't specify their own.  If
+	// unset, failed items in the queue are not retried.
+	RetryPolicy *queue.RetryPolicy `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// config holds the queue's initial settings.  A retry policy in config takes precedence
+	// over retry_policy.
+	Config *QueueConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *CreateQueueInput) Reset() {
+	*x = CreateQueueInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQueueInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueInput) ProtoMessage() {}
+
+func (x *CreateQueueInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueInput.ProtoReflect.Descriptor instead.
+func (*CreateQueueInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateQueueInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateQueueInput) GetRetryPolicy() *queue.RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateQueueInput) GetConfig() *QueueConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// CreateQueueResult is the response from CreateQueue
+type CreateQueueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the identifier for the newly created queue
+	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateQueueResult) Reset() {
+	*x = CreateQueueResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateQueueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueResult) ProtoMessage() {}
+
+func (x *CreateQueueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueResult.ProtoReflect.Descriptor instead.
+func (*CreateQueueResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateQueueResult) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// PaginationParameters defines how result lists should be truncated
+// such that a client can implement pagination.
+type PaginationParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// limit is the maximum number of items to return.  In results it is the limit which was
+	// applied: the default page size if none was given, and never more than the maximum page size.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next is an opaque cursor identifying where the page should begin.  It should be empty
+	// for the first page, and thereafter set to the value returned by the previous call.
+	// A returned cursor which is empty indicates that there are no more results.  The sort
+	// order must not be changed between calls which share a cursor.
+	Next *queue.Identifier `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *PaginationParameters) Reset() {
+	*x = PaginationParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginationParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationParameters) ProtoMessage() {}
+
+func (x *PaginationParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationParameters.ProtoReflect.Descriptor instead.
+func (*PaginationParameters) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PaginationParameters) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PaginationParameters) GetNext() *queue.Identifier {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+// Worker describes a registered downloader.
+type Worker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id identifies the worker, and is assigned when it registers.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the worker's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// host is the name of the machine the worker runs on.
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	// version is the version of the downloader.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// schemes lists the URL schemes the worker can download from.
+	Schemes []string `protobuf:"bytes,5,rep,name=schemes,proto3" json:"schemes,omitempty"`
+	// rate_limit_bytes_per_second is the worker's own download rate limit, or zero if it
+	// isn't limited.
+	RateLimitBytesPerSecond uint64 `protobuf:"varint,6,opt,name=rate_limit_bytes_per_second,json=rateLimitBytesPerSecond,proto3" json:"rate_limit_bytes_per_second,omitempty"`
+	// current_item is the item the worker is downloading, if any.
+	CurrentItem *queue.Identifier `protobuf:"bytes,7,opt,name=current_item,json=currentItem,proto3" json:"current_item,omitempty"`
+	// registered is when the worker registered.
+	Registered *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=registered,proto3" json:"registered,omitempty"`
+	// last_seen is when the worker last registered or sent a heartbeat.
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *Worker) Reset() {
+	*x = Worker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Worker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Worker) ProtoMessage() {}
+
+func (x *Worker) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Worker.ProtoReflect.Descriptor instead.
+func (*Worker) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Worker) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Worker) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Worker) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Worker) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Worker) GetSchemes() []string {
+	if x != nil {
+		return x.Schemes
+	}
+	return nil
+}
+
+func (x *Worker) GetRateLimitBytesPerSecond() uint64 {
+	if x != nil {
+		return x.RateLimitBytesPerSecond
+	}
+	return 0
+}
+
+func (x *Worker) GetCurrentItem() *queue.Identifier {
+	if x != nil {
+		return x.CurrentItem
+	}
+	return nil
+}
+
+func (x *Worker) GetRegistered() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Registered
+	}
+	return nil
+}
+
+func (x *Worker) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+// RegisterWorkerInput is the input to RegisterWorker
+type RegisterWorkerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host                    string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Version                 string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Schemes                 []string `protobuf:"bytes,4,rep,name=schemes,proto3" json:"schemes,omitempty"`
+	RateLimitBytesPerSecond uint64   `protobuf:"varint,5,opt,name=rate_limit_bytes_per_second,json=rateLimitBytesPerSecond,proto3" json:"rate_limit_bytes_per_second,omitempty"`
+}
+
+func (x *RegisterWorkerInput) Reset() {
+	*x = RegisterWorkerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWorkerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWorkerInput) ProtoMessage() {}
+
+func (x *RegisterWorkerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWorkerInput.ProtoReflect.Descriptor instead.
+func (*RegisterWorkerInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RegisterWorkerInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterWorkerInput) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RegisterWorkerInput) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RegisterWorkerInput) GetSchemes() []string {
+	if x != nil {
+		return x.Schemes
+	}
+	return nil
+}
+
+func (x *RegisterWorkerInput) GetRateLimitBytesPerSecond() uint64 {
+	if x != nil {
+		return x.RateLimitBytesPerSecond
+	}
+	return 0
+}
+
+// RegisterWorkerResult is the response from RegisterWorker
+type RegisterWorkerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// worker_id identifies the worker in subsequent calls.
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// heartbeat_interval is how often the worker should send a heartbeat.
+	HeartbeatInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+}
+
+func (x *RegisterWorkerResult) Reset() {
+	*x = RegisterWorkerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterWorkerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWorkerResult) ProtoMessage() {}
+
+func (x *RegisterWorkerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWorkerResult.ProtoReflect.Descriptor instead.
+func (*RegisterWorkerResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RegisterWorkerResult) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *RegisterWorkerResult) GetHeartbeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return nil
+}
+
+// HeartbeatInput is the input to Heartbeat
+type HeartbeatInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// current_item is the item the worker is downloading, if any.
+	CurrentItem *queue.Identifier `protobuf:"bytes,2,opt,name=current_item,json=currentItem,proto3" json:"current_item,omitempty"`
+}
+
+func (x *HeartbeatInput) Reset() {
+	*x = HeartbeatInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatInput) ProtoMessage() {}
+
+func (x *HeartbeatInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatInput.ProtoReflect.Descriptor instead.
+func (*HeartbeatInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *HeartbeatInput) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *HeartbeatInput) GetCurrentItem() *queue.Identifier {
+	if x != nil {
+		return x.CurrentItem
+	}
+	return nil
+}
+
+// HeartbeatResult is the response from Heartbeat
+type HeartbeatResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HeartbeatResult) Reset() {
+	*x = HeartbeatResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResult) ProtoMessage() {}
+
+func (x *HeartbeatResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResult.ProtoReflect.Descriptor instead.
+func (*HeartbeatResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{55}
+}
+
+// ListWorkersInput is the input to ListWorkers
+type ListWorkersInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWorkersInput) Reset() {
+	*x = ListWorkersInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkersInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersInput) ProtoMessage() {}
+
+func (x *ListWorkersInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersInput.ProtoReflect.Descriptor instead.
+func (*ListWorkersInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{56}
+}
+
+// ListWorkersResult is the response from ListWorkers
+type ListWorkersResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// workers lists the registered workers, ordered by name.
+	Workers []*Worker `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+}
+
+func (x *ListWorkersResult) Reset() {
+	*x = ListWorkersResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkersResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersResult) ProtoMessage() {}
+
+func (x *ListWorkersResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersResult.ProtoReflect.Descriptor instead.
+func (*ListWorkersResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListWorkersResult) GetWorkers() []*Worker {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
+// BackupInput is the input to Backup
+type BackupInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format BackupInput_Format `protobuf:"varint,1,opt,name=format,proto3,enum=queue_svc.BackupInput_Format" json:"format,omitempty"`
+}
+
+func (x *BackupInput) Reset() {
+	*x = BackupInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupInput) ProtoMessage() {}
+
+func (x *BackupInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupInput.ProtoReflect.Descriptor instead.
+func (*BackupInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BackupInput) GetFormat() BackupInput_Format {
+	if x != nil {
+		return x.Format
+	}
+	return BackupInput_FORMAT_UNSPECIFIED
+}
+
+// BackupChunk is a piece of the backup.  The backup is the concatenation of the chunks'
+// data, in the order they are received.
+type BackupChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BackupChunk) Reset() {
+	*x = BackupChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupChunk) ProtoMessage() {}
+
+func (x *BackupChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupChunk.ProtoReflect.Descriptor instead.
+func (*BackupChunk) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BackupChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ApiKey describes an API key.
+type ApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role    Role                   `protobuf:"varint,2,opt,name=role,proto3,enum=queue_svc.Role" json:"role,omitempty"`
+	Created *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	// rotated is when the key's secret was last replaced, if it has been.
+	Rotated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=rotated,proto3" json:"rotated,omitempty"`
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (x *ApiKey) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRotated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Rotated
+	}
+	return nil
+}
+
+// CreateKeyInput is the input to CreateKey
+type CreateKeyInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the key, and must be unique.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role Role   `protobuf:"varint,2,opt,name=role,proto3,enum=queue_svc.Role" json:"role,omitempty"`
+}
+
+func (x *CreateKeyInput) Reset() {
+	*x = CreateKeyInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKeyInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeyInput) ProtoMessage() {}
+
+func (x *CreateKeyInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeyInput.ProtoReflect.Descriptor instead.
+func (*CreateKeyInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateKeyInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateKeyInput) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+// CreateKeyResult is the response from CreateKey
+type CreateKeyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *ApiKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// secret is the value to pass as the authorization metadata.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateKeyResult) Reset() {
+	*x = CreateKeyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeyResult) ProtoMessage() {}
+
+func (x *CreateKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeyResult.ProtoReflect.Descriptor instead.
+func (*CreateKeyResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateKeyResult) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateKeyResult) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// RevokeKeyInput is the input to RevokeKey
+type RevokeKeyInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RevokeKeyInput) Reset() {
+	*x = RevokeKeyInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeKeyInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKeyInput) ProtoMessage() {}
+
+func (x *RevokeKeyInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKeyInput.ProtoReflect.Descriptor instead.
+func (*RevokeKeyInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RevokeKeyInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RevokeKeyResult is the response from RevokeKey
+type RevokeKeyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeKeyResult) Reset() {
+	*x = RevokeKeyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKeyResult) ProtoMessage() {}
+
+func (x *RevokeKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKeyResult.ProtoReflect.Descriptor instead.
+func (*RevokeKeyResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{64}
+}
+
+// RotateKeyInput is the input to RotateKey
+type RotateKeyInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RotateKeyInput) Reset() {
+	*x = RotateKeyInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateKeyInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateKeyInput) ProtoMessage() {}
+
+func (x *RotateKeyInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateKeyInput.ProtoReflect.Descriptor instead.
+func (*RotateKeyInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RotateKeyInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RotateKeyResult is the response from RotateKey
+type RotateKeyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key *ApiKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// secret is the key's new secret.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *RotateKeyResult) Reset() {
+	*x = RotateKeyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateKeyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateKeyResult) ProtoMessage() {}
+
+func (x *RotateKeyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateKeyResult.ProtoReflect.Descriptor instead.
+func (*RotateKeyResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RotateKeyResult) GetKey() *ApiKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *RotateKeyResult) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ListKeysInput is the input to ListKeys
+type ListKeysInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListKeysInput) Reset() {
+	*x = ListKeysInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKeysInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeysInput) ProtoMessage() {}
+
+func (x *ListKeysInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeysInput.ProtoReflect.Descriptor instead.
+func (*ListKeysInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{67}
+}
+
+// ListKeysResult is the response from ListKeys
+type ListKeysResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keys lists the API keys, ordered by name.
+	Keys []*ApiKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *ListKeysResult) Reset() {
+	*x = ListKeysResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKeysResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKeysResult) ProtoMessage() {}
+
+func (x *ListKeysResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKeysResult.ProtoReflect.Descriptor instead.
+func (*ListKeysResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListKeysResult) GetKeys() []*ApiKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// CategoryConfig holds the settings of a category.
+type CategoryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the category's name, as given to its items.
+	Id *queue.Identifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// destination_template is the directory, relative to the downloader's download directory,
+	// under which the category's items with a relative destination are saved.  It may contain
+	// the placeholders {category}, {host} (the host of the item's source) and {date} (the
+	// date the download starts, as YYYY-MM-DD).  If empty, destinations are left as they are.
+	DestinationTemplate string `protobuf:"bytes,2,opt,name=destination_template,json=destinationTemplate,proto3" json:"destination_template,omitempty"`
+	// rate_limit_bytes_per_second is the download rate of the category's items, which takes
+	// precedence over their queue's default.  Zero leaves the rate to the queue.
+	RateLimitBytesPerSecond uint64 `protobuf:"varint,3,opt,name=rate_limit_bytes_per_second,json=rateLimitBytesPerSecond,proto3" json:"rate_limit_bytes_per_second,omitempty"`
+	// retry_policy is applied to the category's items which don't specify their own, in place
+	// of their queue's policy.
+	RetryPolicy *queue.RetryPolicy `protobuf:"bytes,4,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// allowed_hosts lists the hosts the category's items may be downloaded from, including by
+	// following redirects.  An entry beginning with "*." also allows the subdomains of the rest
+	// of the entry.  If empty, any host is allowed.  Items with sources on other hosts are
+	// rejected when they are enqueued, changed or requeued.
+	AllowedHosts []string `protobuf:"bytes,5,rep,name=allowed_hosts,json=allowedHosts,proto3" json:"allowed_hosts,omitempty"`
+}
+
+func (x *CategoryConfig) Reset() {
+	*x = CategoryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryConfig) ProtoMessage() {}
+
+func (x *CategoryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryConfig.ProtoReflect.Descriptor instead.
+func (*CategoryConfig) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CategoryConfig) GetId() *queue.Identifier {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CategoryConfig) GetDestinationTemplate() string {
+	if x != nil {
+		return x.DestinationTemplate
+	}
+	return ""
+}
+
+func (x *CategoryConfig) GetRateLimitBytesPerSecond() uint64 {
+	if x != nil {
+		return x.RateLimitBytesPerSecond
+	}
+	return 0
+}
+
+func (x *CategoryConfig) GetRetryPolicy() *queue.RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CategoryConfig) GetAllowedHosts() []string {
+	if x != nil {
+		return x.AllowedHosts
+	}
+	return nil
+}
+
+// CreateCategoryInput is the input to CreateCategory
+type CreateCategoryInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// category holds the new category's name and settings.
+	Category *CategoryConfig `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *CreateCategoryInput) Reset() {
+	*x = CreateCategoryInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCategoryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryInput) ProtoMessage() {}
+
+func (x *CreateCategoryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryInput.ProtoReflect.Descriptor instead.
+func (*CreateCategoryInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateCategoryInput) GetCategory() *CategoryConfig {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// CreateCategoryResult is the response from CreateCategory
+type CreateCategoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category *CategoryConfig `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *CreateCategoryResult) Reset() {
+	*x = CreateCategoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCategoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResult) ProtoMessage() {}
+
+func (x *CreateCategoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResult.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateCategoryResult) GetCategory() *CategoryConfig {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// ListCategoriesInput is the input to ListCategories
+type ListCategoriesInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCategoriesInput) Reset() {
+	*x = ListCategoriesInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesInput) ProtoMessage() {}
+
+func (x *ListCategoriesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesInput.ProtoReflect.Descriptor instead.
+func (*ListCategoriesInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{72}
+}
+
+// ListCategoriesResult is the response from ListCategories
+type ListCategoriesResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// categories lists the categories, ordered by name.
+	Categories []*CategoryConfig `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *ListCategoriesResult) Reset() {
+	*x = ListCategoriesResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCategoriesResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResult) ProtoMessage() {}
+
+func (x *ListCategoriesResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResult.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListCategoriesResult) GetCategories() []*CategoryConfig {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// UpdateCategoryInput is the input to UpdateCategory
+type UpdateCategoryInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// category holds the name of the category to change, and its new settings.
+	Category *CategoryConfig `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// update_mask lists the fields of category to apply, such as "allowed_hosts".  If empty,
+	// every field is applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateCategoryInput) Reset() {
+	*x = UpdateCategoryInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCategoryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryInput) ProtoMessage() {}
+
+func (x *UpdateCategoryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryInput.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateCategoryInput) GetCategory() *CategoryConfig {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdateCategoryInput) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateCategoryResult is the response from UpdateCategory
+type UpdateCategoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// category holds the category's settings after the update
+	Category *CategoryConfig `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *UpdateCategoryResult) Reset() {
+	*x = UpdateCategoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCategoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResult) ProtoMessage() {}
+
+func (x *UpdateCategoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResult.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateCategoryResult) GetCategory() *CategoryConfig {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// DeleteCategoryInput is the input to DeleteCategory
+type DeleteCategoryInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category *queue.Identifier `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *DeleteCategoryInput) Reset() {
+	*x = DeleteCategoryInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryInput) ProtoMessage() {}
+
+func (x *DeleteCategoryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryInput.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryInput) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteCategoryInput) GetCategory() *queue.Identifier {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// DeleteCategoryResult is the response from DeleteCategory
+type DeleteCategoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCategoryResult) Reset() {
+	*x = DeleteCategoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_queue_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCategoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResult) ProtoMessage() {}
+
+func (x *DeleteCategoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResult.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResult) Descriptor() ([]byte, []int) {
+	return file_queue_service_proto_rawDescGZIP(), []int{77}
+}
+
+var File_queue_service_proto protoreflect.FileDescriptor
+
+var file_queue_service_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x1a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb0, 0x03, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x36, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x74, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4b, 0x0a, 0x23, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x1e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x48, 0x0a, 0x11, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x3f, 0x0a,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x22, 0x3e,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x46,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x49, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x7a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x1b,
+	0x0a, 0x19, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x0d,
+	0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x09, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4c, 0x41, 0x43, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x10, 0x02, 0x22, 0x10, 0x0a,
+	0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x39, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x6f,
+	0x76, 0x65, 0x54, 0x6f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x38, 0x0a, 0x0f, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x6f, 0x76,
+	0x65, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbf, 0x01,
+	0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22,
+	0x4f, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x5b, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xf7, 0x03,
+	0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0xa5, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55,
+	0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c,
+	0x0a, 0x18, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19,
+	0x51, 0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x51,
+	0x55, 0x45, 0x55, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x08, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x36, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x4f, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3b, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6b, 0x0a, 0x11, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2e, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x53,
+	0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22,
+	0x98, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf6, 0x02, 0x0a, 0x13, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x3c, 0x0a, 0x1b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x36, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x74, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22,
+	0x83, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xd6, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x52,
+	0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x41, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x6f, 0x72,
+	0x74, 0x12, 0x2f, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x53, 0x6f, 0x72, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x9e, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55,
+	0x4c, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45,
+	0x4c, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12,
+	0x17, 0x0a, 0x13, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x53, 0x49, 0x5a,
+	0x45, 0x10, 0x05, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb1, 0x04, 0x0a, 0x1c, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x26,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0xe4, 0x06,
+	0x0a, 0x10, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x59,
+	0x0a, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x56, 0x0a, 0x10, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x53, 0x0a, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x6d, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x45, 0x4e,
+	0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45,
+	0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x48, 0x4f, 0x4c, 0x44,
+	0x10, 0x02, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10,
+	0x02, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x45, 0x58, 0x49,
+	0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44,
+	0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x55, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49,
+	0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x22, 0x54, 0x0a, 0x11, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x36, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x21, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x53, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x25, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x3c,
+	0x0a, 0x1b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x17, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x37,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1b, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x7d, 0x0a, 0x14, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x48,
+	0x0a, 0x12, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x63, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x11, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x22, 0x40, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x47, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
+	0x4f, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a,
+	0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x22, 0x21, 0x0a, 0x0b, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x06, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x24, 0x0a,
+	0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x0f, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x22, 0x37, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x80, 0x02,
+	0x0a, 0x0e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x21, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1b, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x72, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x22, 0x4c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x4d,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x15, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x22, 0x44, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2a, 0x62, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4b,
+	0x45, 0x52, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d,
+	0x49, 0x4e, 0x10, 0x04, 0x32, 0x98, 0x14, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x45,
+	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x20, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x21, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0a,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x15, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x3f, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x48, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x54, 0x6f, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x46,
+	0x72, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0a, 0x4d, 0x6f,
+	0x76, 0x65, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x45, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x60, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x23, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x24, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x53, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5a, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x21, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x09,
+	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x48, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x12, 0x16, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x16, 0x2e, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42,
+	0x0a, 0x09, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x76, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x18,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b,
+	0x65, 0x79, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x19, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f, 0x2e, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x51, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1f,
+	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_queue_service_proto_rawDescOnce sync.Once
+	file_queue_service_proto_rawDescData = file_queue_service_proto_rawDesc
+)
+
+func file_queue_service_proto_rawDescGZIP() []byte {
+	file_queue_service_proto_rawDescOnce.Do(func() {
+		file_queue_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_queue_service_proto_rawDescData)
+	})
+	return file_queue_service_proto_rawDescData
+}
+
+var file_queue_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_queue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
+var file_queue_service_proto_goTypes = []interface{}{
+	(Role)(0),                              // 0: queue_svc.Role
+	(MoveItemInput_Placement)(0),           // 1: queue_svc.MoveItemInput.Placement
+	(QueueEvent_Type)(0),                   // 2: queue_svc.QueueEvent.Type
+	(ItemSort_Field)(0),                    // 3: queue_svc.ItemSort.Field
+	(EnqueueItemInput_DependencyPolicy)(0), // 4: queue_svc.EnqueueItemInput.DependencyPolicy
+	(EnqueueItemInput_DuplicatePolicy)(0),  // 5: queue_svc.EnqueueItemInput.DuplicatePolicy
+	(EnqueueItemInput_DuplicateMatch)(0),   // 6: queue_svc.EnqueueItemInput.DuplicateMatch
+	(BackupInput_Format)(0),                // 7: queue_svc.BackupInput.Format
+	(*QueueConfig)(nil),                    // 8: queue_svc.QueueConfig
+	(*HistoryRetention)(nil),               // 9: queue_svc.HistoryRetention
+	(*GetQueueConfigInput)(nil),            // 10: queue_svc.GetQueueConfigInput
+	(*GetQueueConfigResult)(nil),           // 11: queue_svc.GetQueueConfigResult
+	(*UpdateQueueConfigInput)(nil),         // 12: queue_svc.UpdateQueueConfigInput
+	(*UpdateQueueConfigResult)(nil),        // 13: queue_svc.UpdateQueueConfigResult
+	(*SetQueueRetryPolicyInput)(nil),       // 14: queue_svc.SetQueueRetryPolicyInput
+	(*SetQueueRetryPolicyResult)(nil),      // 15: queue_svc.SetQueueRetryPolicyResult
+	(*MoveItemInput)(nil),                  // 16: queue_svc.MoveItemInput
+	(*MoveItemResult)(nil),                 // 17: queue_svc.MoveItemResult
+	(*MoveToFrontInput)(nil),               // 18: queue_svc.MoveToFrontInput
+	(*MoveToFrontResult)(nil),              // 19: queue_svc.MoveToFrontResult
+	(*MoveToBackInput)(nil),                // 20: queue_svc.MoveToBackInput
+	(*MoveToBackResult)(nil),               // 21: queue_svc.MoveToBackResult
+	(*UpdateItemInput)(nil),                // 22: queue_svc.UpdateItemInput
+	(*UpdateItemResult)(nil),               // 23: queue_svc.UpdateItemResult
+	(*WatchQueueInput)(nil),                // 24: queue_svc.WatchQueueInput
+	(*QueueEvent)(nil),                     // 25: queue_svc.QueueEvent
+	(*ListQueuesInput)(nil),                // 26: queue_svc.ListQueuesInput
+	(*ListQueueResultItem)(nil),            // 27: queue_svc.ListQueueResultItem
+	(*ListQueuesResult)(nil),               // 28: queue_svc.ListQueuesResult
+	(*DeleteQueueInput)(nil),               // 29: queue_svc.DeleteQueueInput
+	(*DeleteQueueResult)(nil),              // 30: queue_svc.DeleteQueueResult
+	(*RenameQueueInput)(nil),               // 31: queue_svc.RenameQueueInput
+	(*RenameQueueResult)(nil),              // 32: queue_svc.RenameQueueResult
+	(*PauseQueueInput)(nil),                // 33: queue_svc.PauseQueueInput
+	(*PauseQueueResult)(nil),               // 34: queue_svc.PauseQueueResult
+	(*ResumeQueueInput)(nil),               // 35: queue_svc.ResumeQueueInput
+	(*ResumeQueueResult)(nil),              // 36: queue_svc.ResumeQueueResult
+	(*ClearHistoryInput)(nil),              // 37: queue_svc.ClearHistoryInput
+	(*ClearHistoryResult)(nil),             // 38: queue_svc.ClearHistoryResult
+	(*RequeueItemsInput)(nil),              // 39: queue_svc.RequeueItemsInput
+	(*RequeueItemsResult)(nil),             // 40: queue_svc.RequeueItemsResult
+	(*GetFinishedItemsInput)(nil),          // 41: queue_svc.GetFinishedItemsInput
+	(*GetFinishedItemsResult)(nil),         // 42: queue_svc.GetFinishedItemsResult
+	(*ClaimNextItemInput)(nil),             // 43: queue_svc.ClaimNextItemInput
+	(*ClaimNextItemResult)(nil),            // 44: queue_svc.ClaimNextItemResult
+	(*SetItemStateInput)(nil),              // 45: queue_svc.SetItemStateInput
+	(*SetItemStateResult)(nil),             // 46: queue_svc.SetItemStateResult
+	(*GetQueueItemsInput)(nil),             // 47: queue_svc.GetQueueItemsInput
+	(*ItemFilter)(nil),                     // 48: queue_svc.ItemFilter
+	(*ItemSort)(nil),                       // 49: queue_svc.ItemSort
+	(*GetQueueItemsResult)(nil),            // 50: queue_svc.GetQueueItemsResult
+	(*CancelItemInput)(nil),                // 51: queue_svc.CancelItemInput
+	(*CancelItemResult)(nil),               // 52: queue_svc.CancelItemResult
+	(*IdentifiedQueueItemWithState)(nil),   // 53: queue_svc.IdentifiedQueueItemWithState
+	(*EnqueueItemInput)(nil),               // 54: queue_svc.EnqueueItemInput
+	(*EnqueueItemResult)(nil),              // 55: queue_svc.EnqueueItemResult
+	(*CreateQueueInput)(nil),               // 56: queue_svc.CreateQueueInput
+	(*CreateQueueResult)(nil),              // 57: queue_svc.CreateQueueResult
+	(*PaginationParameters)(nil),           // 58: queue_svc.PaginationParameters
+	(*Worker)(nil),                         // 59: queue_svc.Worker
+	(*RegisterWorkerInput)(nil),            // 60: queue_svc.RegisterWorkerInput
+	(*RegisterWorkerResult)(nil),           // 61: queue_svc.RegisterWorkerResult
+	(*HeartbeatInput)(nil),                 // 62: queue_svc.HeartbeatInput
+	(*HeartbeatResult)(nil),                // 63: queue_svc.HeartbeatResult
+	(*ListWorkersInput)(nil),               // 64: queue_svc.ListWorkersInput
+	(*ListWorkersResult)(nil),              // 65: queue_svc.ListWorkersResult
+	(*BackupInput)(nil),                    // 66: queue_svc.BackupInput
+	(*BackupChunk)(nil),                    // 67: queue_svc.BackupChunk
+	(*ApiKey)(nil),                         // 68: queue_svc.ApiKey
+	(*CreateKeyInput)(nil),                 // 69: queue_svc.CreateKeyInput
+	(*CreateKeyResult)(nil),                // 70: queue_svc.CreateKeyResult
+	(*RevokeKeyInput)(nil),                 // 71: queue_svc.RevokeKeyInput
+	(*RevokeKeyResult)(nil),                // 72: queue_svc.RevokeKeyResult
+	(*RotateKeyInput)(nil),                 // 73: queue_svc.RotateKeyInput
+	(*RotateKeyResult)(nil),                // 74: queue_svc.RotateKeyResult
+	(*ListKeysInput)(nil),                  // 75: queue_svc.ListKeysInput
+	(*ListKeysResult)(nil),                 // 76: queue_svc.ListKeysResult
+	(*CategoryConfig)(nil),                 // 77: queue_svc.CategoryConfig
+	(*CreateCategoryInput)(nil),            // 78: queue_svc.CreateCategoryInput
+	(*CreateCategoryResult)(nil),           // 79: queue_svc.CreateCategoryResult
+	(*ListCategoriesInput)(nil),            // 80: queue_svc.ListCategoriesInput
+	(*ListCategoriesResult)(nil),           // 81: queue_svc.ListCategoriesResult
+	(*UpdateCategoryInput)(nil),            // 82: queue_svc.UpdateCategoryInput
+	(*UpdateCategoryResult)(nil),           // 83: queue_svc.UpdateCategoryResult
+	(*DeleteCategoryInput)(nil),            // 84: queue_svc.DeleteCategoryInput
+	(*DeleteCategoryResult)(nil),           // 85: queue_svc.DeleteCategoryResult
+	(*durationpb.Duration)(nil),            // 86: google.protobuf.Duration
+	(*queue.RetryPolicy)(nil),              // 87: queue.RetryPolicy
+	(*queue.Identifier)(nil),               // 88: queue.Identifier
+	(*fieldmaskpb.FieldMask)(nil),          // 89: google.protobuf.FieldMask
+	(*queue.Item)(nil),                     // 90: queue.Item
+	(*timestamppb.Timestamp)(nil),          // 91: google.protobuf.Timestamp
+	(*queue.ItemState)(nil),                // 92: queue.ItemState
+	(queue.ItemState_State)(0),             // 93: queue.ItemState.State
+	(*queue.Attempt)(nil),                  // 94: queue.Attempt
+	(*queue.Target)(nil),                   // 95: queue.Target
+}
+var file_queue_service_proto_depIdxs = []int32{
+	86,  // 0: queue_svc.QueueConfig.claim_ttl:type_name -> google.protobuf.Duration
+	87,  // 1: queue_svc.QueueConfig.retry_policy:type_name -> queue.RetryPolicy
+	9,   // 2: queue_svc.QueueConfig.history_retention:type_name -> queue_svc.HistoryRetention
+	86,  // 3: queue_svc.HistoryRetention.max_age:type_name -> google.protobuf.Duration
+	86,  // 4: queue_svc.HistoryRetention.failed_max_age:type_name -> google.protobuf.Duration
+	88,  // 5: queue_svc.GetQueueConfigInput.queue:type_name -> queue.Identifier
+	8,   // 6: queue_svc.GetQueueConfigResult.config:type_name -> queue_svc.QueueConfig
+	88,  // 7: queue_svc.UpdateQueueConfigInput.queue:type_name -> queue.Identifier
+	8,   // 8: queue_svc.UpdateQueueConfigInput.config:type_name -> queue_svc.QueueConfig
+	89,  // 9: queue_svc.UpdateQueueConfigInput.update_mask:type_name -> google.protobuf.FieldMask
+	8,   // 10: queue_svc.UpdateQueueConfigResult.config:type_name -> queue_svc.QueueConfig
+	88,  // 11: queue_svc.SetQueueRetryPolicyInput.queue:type_name -> queue.Identifier
+	87,  // 12: queue_svc.SetQueueRetryPolicyInput.retry_policy:type_name -> queue.RetryPolicy
+	88,  // 13: queue_svc.MoveItemInput.item:type_name -> queue.Identifier
+	88,  // 14: queue_svc.MoveItemInput.relative_to:type_name -> queue.Identifier
+	1,   // 15: queue_svc.MoveItemInput.placement:type_name -> queue_svc.MoveItemInput.Placement
+	88,  // 16: queue_svc.MoveToFrontInput.item:type_name -> queue.Identifier
+	88,  // 17: queue_svc.MoveToBackInput.item:type_name -> queue.Identifier
+	88,  // 18: queue_svc.UpdateItemInput.item:type_name -> queue.Identifier
+	90,  // 19: queue_svc.UpdateItemInput.changes:type_name -> queue.Item
+	89,  // 20: queue_svc.UpdateItemInput.update_mask:type_name -> google.protobuf.FieldMask
+	53,  // 21: queue_svc.UpdateItemResult.item:type_name -> queue_svc.IdentifiedQueueItemWithState
+	88,  // 22: queue_svc.WatchQueueInput.queue:type_name -> queue.Identifier
+	2,   // 23: queue_svc.QueueEvent.type:type_name -> queue_svc.QueueEvent.Type
+	53,  // 24: queue_svc.QueueEvent.item:type_name -> queue_svc.IdentifiedQueueItemWithState
+	91,  // 25: queue_svc.QueueEvent.timestamp:type_name -> google.protobuf.Timestamp
+	88,  // 26: queue_svc.ListQueueResultItem.id:type_name -> queue.Identifier
+	91,  // 27: queue_svc.ListQueueResultItem.created:type_name -> google.protobuf.Timestamp
+	27,  // 28: queue_svc.ListQueuesResult.queues:type_name -> queue_svc.ListQueueResultItem
+	88,  // 29: queue_svc.DeleteQueueInput.queue:type_name -> queue.Identifier
+	88,  // 30: queue_svc.RenameQueueInput.queue:type_name -> queue.Identifier
+	88,  // 31: queue_svc.PauseQueueInput.queue:type_name -> queue.Identifier
+	88,  // 32: queue_svc.ResumeQueueInput.queue:type_name -> queue.Identifier
+	88,  // 33: queue_svc.ClearHistoryInput.queue:type_name -> queue.Identifier
+	48,  // 34: queue_svc.ClearHistoryInput.filter:type_name -> queue_svc.ItemFilter
+	88,  // 35: queue_svc.RequeueItemsInput.queue:type_name -> queue.Identifier
+	88,  // 36: queue_svc.RequeueItemsInput.items:type_name -> queue.Identifier
+	48,  // 37: queue_svc.RequeueItemsInput.filter:type_name -> queue_svc.ItemFilter
+	53,  // 38: queue_svc.RequeueItemsResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
+	88,  // 39: queue_svc.GetFinishedItemsInput.queue:type_name -> queue.Identifier
+	58,  // 40: queue_svc.GetFinishedItemsInput.pagination:type_name -> queue_svc.PaginationParameters
+	48,  // 41: queue_svc.GetFinishedItemsInput.filter:type_name -> queue_svc.ItemFilter
+	49,  // 42: queue_svc.GetFinishedItemsInput.sort:type_name -> queue_svc.ItemSort
+	58,  // 43: queue_svc.GetFinishedItemsResult.pagination:type_name -> queue_svc.PaginationParameters
+	53,  // 44: queue_svc.GetFinishedItemsResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
+	88,  // 45: queue_svc.ClaimNextItemInput.queue:type_name -> queue.Identifier
+	88,  // 46: queue_svc.ClaimNextItemResult.id:type_name -> queue.Identifier
+	90,  // 47: queue_svc.ClaimNextItemResult.item:type_name -> queue.Item
+	86,  // 48: queue_svc.ClaimNextItemResult.claim_ttl:type_name -> google.protobuf.Duration
+	77,  // 49: queue_svc.ClaimNextItemResult.category:type_name -> queue_svc.CategoryConfig
+	88,  // 50: queue_svc.SetItemStateInput.item:type_name -> queue.Identifier
+	92,  // 51: queue_svc.SetItemStateInput.state:type_name -> queue.ItemState
+	58,  // 52: queue_svc.SetItemStateResult.pagination:type_name -> queue_svc.PaginationParameters
+	53,  // 53: queue_svc.SetItemStateResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
+	88,  // 54: queue_svc.GetQueueItemsInput.queue:type_name -> queue.Identifier
+	58,  // 55: queue_svc.GetQueueItemsInput.pagination:type_name -> queue_svc.PaginationParameters
+	48,  // 56: queue_svc.GetQueueItemsInput.filter:type_name -> queue_svc.ItemFilter
+	49,  // 57: queue_svc.GetQueueItemsInput.sort:type_name -> queue_svc.ItemSort
+	93,  // 58: queue_svc.ItemFilter.states:type_name -> queue.ItemState.State
+	91,  // 59: queue_svc.ItemFilter.updated_before:type_name -> google.protobuf.Timestamp
+	91,  // 60: queue_svc.ItemFilter.updated_after:type_name -> google.protobuf.Timestamp
+	3,   // 61: queue_svc.ItemSort.field:type_name -> queue_svc.ItemSort.Field
+	58,  // 62: queue_svc.GetQueueItemsResult.pagination:type_name -> queue_svc.PaginationParameters
+	53,  // 63: queue_svc.GetQueueItemsResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
+	88,  // 64: queue_svc.CancelItemInput.item:type_name -> queue.Identifier
+	88,  // 65: queue_svc.IdentifiedQueueItemWithState.id:type_name -> queue.Identifier
+	90,  // 66: queue_svc.IdentifiedQueueItemWithState.item:type_name -> queue.Item
+	92,  // 67: queue_svc.IdentifiedQueueItemWithState.state:type_name -> queue.ItemState
+	91,  // 68: queue_svc.IdentifiedQueueItemWithState.updated:type_name -> google.protobuf.Timestamp
+	94,  // 69: queue_svc.IdentifiedQueueItemWithState.attempts:type_name -> queue.Attempt
+	91,  // 70: queue_svc.IdentifiedQueueItemWithState.not_before:type_name -> google.protobuf.Timestamp
+	88,  // 71: queue_svc.IdentifiedQueueItemWithState.previous_run:type_name -> queue.Identifier
+	88,  // 72: queue_svc.IdentifiedQueueItemWithState.depends_on:type_name -> queue.Identifier
+	95,  // 73: queue_svc.IdentifiedQueueItemWithState.next_source:type_name -> queue.Target
+	88,  // 74: queue_svc.IdentifiedQueueItemWithState.requeued_from:type_name -> queue.Identifier
+	88,  // 75: queue_svc.EnqueueItemInput.queue:type_name -> queue.Identifier
+	90,  // 76: queue_svc.EnqueueItemInput.item:type_name -> queue.Item
+	88,  // 77: queue_svc.EnqueueItemInput.depends_on:type_name -> queue.Identifier
+	4,   // 78: queue_svc.EnqueueItemInput.dependency_policy:type_name -> queue_svc.EnqueueItemInput.DependencyPolicy
+	5,   // 79: queue_svc.EnqueueItemInput.duplicate_policy:type_name -> queue_svc.EnqueueItemInput.DuplicatePolicy
+	6,   // 80: queue_svc.EnqueueItemInput.duplicate_match:type_name -> queue_svc.EnqueueItemInput.DuplicateMatch
+	88,  // 81: queue_svc.EnqueueItemResult.id:type_name -> queue.Identifier
+	87,  // 82: queue_svc.CreateQueueInput.retry_policy:type_name -> queue.RetryPolicy
+	8,   // 83: queue_svc.CreateQueueInput.config:type_name -> queue_svc.QueueConfig
+	88,  // 84: queue_svc.CreateQueueResult.id:type_name -> queue.Identifier
+	88,  // 85: queue_svc.PaginationParameters.next:type_name -> queue.Identifier
+	88,  // 86: queue_svc.Worker.current_item:type_name -> queue.Identifier
+	91,  // 87: queue_svc.Worker.registered:type_name -> google.protobuf.Timestamp
+	91,  // 88: queue_svc.Worker.last_seen:type_name -> google.protobuf.Timestamp
+	86,  // 89: queue_svc.RegisterWorkerResult.heartbeat_interval:type_name -> google.protobuf.Duration
+	88,  // 90: queue_svc.HeartbeatInput.current_item:type_name -> queue.Identifier
+	59,  // 91: queue_svc.ListWorkersResult.workers:type_name -> queue_svc.Worker
+	7,   // 92: queue_svc.BackupInput.format:type_name -> queue_svc.BackupInput.Format
+	0,   // 93: queue_svc.ApiKey.role:type_name -> queue_svc.Role
+	91,  // 94: queue_svc.ApiKey.created:type_name -> google.protobuf.Timestamp
+	91,  // 95: queue_svc.ApiKey.rotated:type_name -> google.protobuf.Timestamp
+	0,   // 96: queue_svc.CreateKeyInput.role:type_name -> queue_svc.Role
+	68,  // 97: queue_svc.CreateKeyResult.key:type_name -> queue_svc.ApiKey
+	68,  // 98: queue_svc.RotateKeyResult.key:type_name -> queue_svc.ApiKey
+	68,  // 99: queue_svc.ListKeysResult.keys:type_name -> queue_svc.ApiKey
+	88,  // 100: queue_svc.CategoryConfig.id:type_name -> queue.Identifier
+	87,  // 101: queue_svc.CategoryConfig.retry_policy:type_name -> queue.RetryPolicy
+	77,  // 102: queue_svc.CreateCategoryInput.category:type_name -> queue_svc.CategoryConfig
+	77,  // 103: queue_svc.CreateCategoryResult.category:type_name -> queue_svc.CategoryConfig
+	77,  // 104: queue_svc.ListCategoriesResult.categories:type_name -> queue_svc.CategoryConfig
+	77,  // 105: queue_svc.UpdateCategoryInput.category:type_name -> queue_svc.CategoryConfig
+	89,  // 106: queue_svc.UpdateCategoryInput.update_mask:type_name -> google.protobuf.FieldMask
+	77,  // 107: queue_svc.UpdateCategoryResult.category:type_name -> queue_svc.CategoryConfig
+	88,  // 108: queue_svc.DeleteCategoryInput.category:type_name -> queue.Identifier
+	56,  // 109: queue_svc.QueueService.CreateQueue:input_type -> queue_svc.CreateQueueInput
+	26,  // 110: queue_svc.QueueService.ListQueues:input_type -> queue_svc.ListQueuesInput
+	29,  // 111: queue_svc.QueueService.DeleteQueue:input_type -> queue_svc.DeleteQueueInput
+	31,  // 112: queue_svc.QueueService.RenameQueue:input_type -> queue_svc.RenameQueueInput
+	33,  // 113: queue_svc.QueueService.PauseQueue:input_type -> queue_svc.PauseQueueInput
+	35,  // 114: queue_svc.QueueService.ResumeQueue:input_type -> queue_svc.ResumeQueueInput
+	54,  // 115: queue_svc.QueueService.EnqueueItem:input_type -> queue_svc.EnqueueItemInput
+	51,  // 116: queue_svc.QueueService.CancelItem:input_type -> queue_svc.CancelItemInput
+	47,  // 117: queue_svc.QueueService.GetQueueItems:input_type -> queue_svc.GetQueueItemsInput
+	41,  // 118: queue_svc.QueueService.GetFinishedItems:input_type -> queue_svc.GetFinishedItemsInput
+	45,  // 119: queue_svc.QueueService.SetItemState:input_type -> queue_svc.SetItemStateInput
+	43,  // 120: queue_svc.QueueService.ClaimNextItem:input_type -> queue_svc.ClaimNextItemInput
+	37,  // 121: queue_svc.QueueService.ClearHistory:input_type -> queue_svc.ClearHistoryInput
+	39,  // 122: queue_svc.QueueService.RequeueItems:input_type -> queue_svc.RequeueItemsInput
+	24,  // 123: queue_svc.QueueService.WatchQueue:input_type -> queue_svc.WatchQueueInput
+	16,  // 124: queue_svc.QueueService.MoveItem:input_type -> queue_svc.MoveItemInput
+	18,  // 125: queue_svc.QueueService.MoveToFront:input_type -> queue_svc.MoveToFrontInput
+	20,  // 126: queue_svc.QueueService.MoveToBack:input_type -> queue_svc.MoveToBackInput
+	22,  // 127: queue_svc.QueueService.UpdateItem:input_type -> queue_svc.UpdateItemInput
+	14,  // 128: queue_svc.QueueService.SetQueueRetryPolicy:input_type -> queue_svc.SetQueueRetryPolicyInput
+	10,  // 129: queue_svc.QueueService.GetQueueConfig:input_type -> queue_svc.GetQueueConfigInput
+	12,  // 130: queue_svc.QueueService.UpdateQueueConfig:input_type -> queue_svc.UpdateQueueConfigInput
+	60,  // 131: queue_svc.QueueService.RegisterWorker:input_type -> queue_svc.RegisterWorkerInput
+	62,  // 132: queue_svc.QueueService.Heartbeat:input_type -> queue_svc.HeartbeatInput
+	64,  // 133: queue_svc.QueueService.ListWorkers:input_type -> queue_svc.ListWorkersInput
+	66,  // 134: queue_svc.QueueService.Backup:input_type -> queue_svc.BackupInput
+	69,  // 135: queue_svc.QueueService.CreateKey:input_type -> queue_svc.CreateKeyInput
+	71,  // 136: queue_svc.QueueService.RevokeKey:input_type -> queue_svc.RevokeKeyInput
+	73,  // 137: queue_svc.QueueService.RotateKey:input_type -> queue_svc.RotateKeyInput
+	75,  // 138: queue_svc.QueueService.ListKeys:input_type -> queue_svc.ListKeysInput
+	78,  // 139: queue_svc.QueueService.CreateCategory:input_type -> queue_svc.CreateCategoryInput
+	80,  // 140: queue_svc.QueueService.ListCategories:input_type -> queue_svc.ListCategoriesInput
+	82,  // 141: queue_svc.QueueService.UpdateCategory:input_type -> queue_svc.UpdateCategoryInput
+	84,  // 142: queue_svc.QueueService.DeleteCategory:input_type -> queue_svc.DeleteCategoryInput
+	57,  // 143: queue_svc.QueueService.CreateQueue:output_type -> queue_svc.CreateQueueResult
+	28,  // 144: queue_svc.QueueService.ListQueues:output_type -> queue_svc.ListQueuesResult
+	30,  // 145: queue_svc.QueueService.DeleteQueue:output_type -> queue_svc.DeleteQueueResult
+	32,  // 146: queue_svc.QueueService.RenameQueue:output_type -> queue_svc.RenameQueueResult
+	34,  // 147: queue_svc.QueueService.PauseQueue:output_type -> queue_svc.PauseQueueResult
+	36,  // 148: queue_svc.QueueService.ResumeQueue:output_type -> queue_svc.ResumeQueueResult
+	55,  // 149: queue_svc.QueueService.EnqueueItem:output_type -> queue_svc.EnqueueItemResult
+	52,  // 150: queue_svc.QueueService.CancelItem:output_type -> queue_svc.CancelItemResult
+	50,  // 151: queue_svc.QueueService.GetQueueItems:output_type -> queue_svc.GetQueueItemsResult
+	42,  // 152: queue_svc.QueueService.GetFinishedItems:output_type -> queue_svc.GetFinishedItemsResult
+	46,  // 153: queue_svc.QueueService.SetItemState:output_type -> queue_svc.SetItemStateResult
+	44,  // 154: queue_svc.QueueService.ClaimNextItem:output_type -> queue_svc.ClaimNextItemResult
+	38,  // 155: queue_svc.QueueService.ClearHistory:output_type -> queue_svc.ClearHistoryResult
+	40,  // 156: queue_svc.QueueService.RequeueItems:output_type -> queue_svc.RequeueItemsResult
+	25,  // 157: queue_svc.QueueService.WatchQueue:output_type -> queue_svc.QueueEvent
+	17,  // 158: queue_svc.QueueService.MoveItem:output_type -> queue_svc.MoveItemResult
+	19,  // 159: queue_svc.QueueService.MoveToFront:output_type -> queue_svc.MoveToFrontResult
+	21,  // 160: queue_svc.QueueService.MoveToBack:output_type -> queue_svc.MoveToBackResult
+	23,  // 161: queue_svc.QueueService.UpdateItem:output_type -> queue_svc.UpdateItemResult
+	15,  // 162: queue_svc.QueueService.SetQueueRetryPolicy:output_type -> queue_svc.SetQueueRetryPolicyResult
+	11,  // 163: queue_svc.QueueService.GetQueueConfig:output_type -> queue_svc.GetQueueConfigResult
+	13,  // 164: queue_svc.QueueService.UpdateQueueConfig:output_type -> queue_svc.UpdateQueueConfigResult
+	61,  // 165: queue_svc.QueueService.RegisterWorker:output_type -> queue_svc.RegisterWorkerResult
+	63,  // 166: queue_svc.QueueService.Heartbeat:output_type -> queue_svc.HeartbeatResult
+	65,  // 167: queue_svc.QueueService.ListWorkers:output_type -> queue_svc.ListWorkersResult
+	67,  // 168: queue_svc.QueueService.Backup:output_type -> queue_svc.BackupChunk
+	70,  // 169: queue_svc.QueueService.CreateKey:output_type -> queue_svc.CreateKeyResult
+	72,  // 170: queue_svc.QueueService.RevokeKey:output_type -> queue_svc.RevokeKeyResult
+	74,  // 171: queue_svc.QueueService.RotateKey:output_type -> queue_svc.RotateKeyResult
+	76,  // 172: queue_svc.QueueService.ListKeys:output_type -> queue_svc.ListKeysResult
+	79,  // 173: queue_svc.QueueService.CreateCategory:output_type -> queue_svc.CreateCategoryResult
+	81,  // 174: queue_svc.QueueService.ListCategories:output_type -> queue_svc.ListCategoriesResult
+	83,  // 175: queue_svc.QueueService.UpdateCategory:output_type -> queue_svc.UpdateCategoryResult
+	85,  // 176: queue_svc.QueueService.DeleteCategory:output_type -> queue_svc.DeleteCategoryResult
+	143, // [143:177] is the sub-list for method output_type
+	109, // [109:143] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
+}
+
+func init() { file_queue_service_proto_init() }
+func file_queue_service_proto_init() {
+	if File_queue_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_queue_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HistoryRetention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQueueConfigInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQueueConfigResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQueueConfigInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateQueueConfigResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetQueueRetryPolicyInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetQueueRetryPolicyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveItemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToFrontInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToFrontResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToBackInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToBackResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateItemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueueEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuesInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueueResultItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQueuesResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearHistoryInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearHistoryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueItemsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueItemsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinishedItemsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinishedItemsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimNextItemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimNextItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetItemStateInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetItemStateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQueueItemsInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQueueItemsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelItemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentifiedQueueItemWithState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueItemInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnqueueItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQueueInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQueueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Worker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterWorkerInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterWorkerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateQueueResult.ProtoReflect.Descriptor instead.
-func (*CreateQueueResult) Descriptor() ([]byte, []int) {
-	return file_queue_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *CreateQueueResult) GetId() *queue.Identifier {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-// PaginationParameters defines how result lists should be truncated
-// such that a client can implement pagination.
-type PaginationParameters struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// limit is the maximum number of items to return
-	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	// next is the id of the first result that should be returned
-	Next *queue.Identifier `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
-}
-
-func (x *PaginationParameters) Reset() {
-	*x = PaginationParameters{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_queue_service_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PaginationParameters) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PaginationParameters) ProtoMessage() {}
-
-func (x *PaginationParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_service_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_queue_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PaginationParameters.ProtoReflect.Descriptor instead.
-func (*PaginationParameters) Descriptor() ([]byte, []int) {
-	return file_queue_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *PaginationParameters) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *PaginationParameters) GetNext() *queue.Identifier {
-	if x != nil {
-		return x.Next
-	}
-	return nil
-}
-
-var File_queue_service_proto protoreflect.FileDescriptor
-
-var file_queue_service_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
-	0x1a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11,
-	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x22, 0x29, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x10,
-	0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x36, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x81, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
-	0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x98, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3d, 0x0a, 0x12, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x13, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x62, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x53, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x22, 0x7e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
-	0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e,
-	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x95, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x69,
-	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x1c, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x57, 0x69,
-	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x45, 0x6e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x27, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x11, 0x45, 0x6e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x26, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x53, 0x0a, 0x14, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a,
-	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x04,
-	0x6e, 0x65, 0x78, 0x74, 0x32, 0xc3, 0x05, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x12, 0x1a, 0x2e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76,
-	0x63, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x45,
-	0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x45, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a,
-	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
-	0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x20, 0x2e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x21, 0x2e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1c, 0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x53, 0x65, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d,
-	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a,
-	0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d,
-	0x2e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x4e, 0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x2e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4e,
-	0x65, 0x78, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4b, 0x0a,
-	0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x5f, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_queue_service_proto_rawDescOnce sync.Once
-	file_queue_service_proto_rawDescData = file_queue_service_proto_rawDesc
-)
-
-func file_queue_service_proto_rawDescGZIP() []byte {
-	file_queue_service_proto_rawDescOnce.Do(func() {
-		file_queue_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_queue_service_proto_rawDescData)
-	})
-	return file_queue_service_proto_rawDescData
-}
-
-var file_queue_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_queue_service_proto_goTypes = []interface{}{
-	(*ListQueuesInput)(nil),              // 0: queue_svc.ListQueuesInput
-	(*ListQueueResultItem)(nil),          // 1: queue_svc.ListQueueResultItem
-	(*ListQueuesResult)(nil),             // 2: queue_svc.ListQueuesResult
-	(*ClearHistoryInput)(nil),            // 3: queue_svc.ClearHistoryInput
-	(*ClearHistoryResult)(nil),           // 4: queue_svc.ClearHistoryResult
-	(*GetFinishedItemsInput)(nil),        // 5: queue_svc.GetFinishedItemsInput
-	(*GetFinishedItemsResult)(nil),       // 6: queue_svc.GetFinishedItemsResult
-	(*ClaimNextItemInput)(nil),           // 7: queue_svc.ClaimNextItemInput
-	(*ClaimNextItemResult)(nil),          // 8: queue_svc.ClaimNextItemResult
-	(*SetItemStateInput)(nil),            // 9: queue_svc.SetItemStateInput
-	(*SetItemStateResult)(nil),           // 10: queue_svc.SetItemStateResult
-	(*GetQueueItemsInput)(nil),           // 11: queue_svc.GetQueueItemsInput
-	(*GetQueueItemsResult)(nil),          // 12: queue_svc.GetQueueItemsResult
-	(*CancelItemInput)(nil),              // 13: queue_svc.CancelItemInput
-	(*CancelItemResult)(nil),             // 14: queue_svc.CancelItemResult
-	(*IdentifiedQueueItemWithState)(nil), // 15: queue_svc.IdentifiedQueueItemWithState
-	(*EnqueueItemInput)(nil),             // 16: queue_svc.EnqueueItemInput
-	(*EnqueueItemResult)(nil),            // 17: queue_svc.EnqueueItemResult
-	(*CreateQueueInput)(nil),             // 18: queue_svc.CreateQueueInput
-	(*CreateQueueResult)(nil),            // 19: queue_svc.CreateQueueResult
-	(*PaginationParameters)(nil),         // 20: queue_svc.PaginationParameters
-	(*queue.Identifier)(nil),             // 21: queue.Identifier
-	(*queue.Item)(nil),                   // 22: queue.Item
-	(*queue.ItemState)(nil),              // 23: queue.ItemState
-	(*timestamppb.Timestamp)(nil),        // 24: google.protobuf.Timestamp
-}
-var file_queue_service_proto_depIdxs = []int32{
-	1,  // 0: queue_svc.ListQueuesResult.queues:type_name -> queue_svc.ListQueueResultItem
-	21, // 1: queue_svc.ClearHistoryInput.queue:type_name -> queue.Identifier
-	21, // 2: queue_svc.GetFinishedItemsInput.queue:type_name -> queue.Identifier
-	20, // 3: queue_svc.GetFinishedItemsInput.pagination:type_name -> queue_svc.PaginationParameters
-	20, // 4: queue_svc.GetFinishedItemsResult.pagination:type_name -> queue_svc.PaginationParameters
-	15, // 5: queue_svc.GetFinishedItemsResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
-	21, // 6: queue_svc.ClaimNextItemInput.queue:type_name -> queue.Identifier
-	21, // 7: queue_svc.ClaimNextItemResult.id:type_name -> queue.Identifier
-	22, // 8: queue_svc.ClaimNextItemResult.item:type_name -> queue.Item
-	21, // 9: queue_svc.SetItemStateInput.item:type_name -> queue.Identifier
-	23, // 10: queue_svc.SetItemStateInput.state:type_name -> queue.ItemState
-	20, // 11: queue_svc.SetItemStateResult.pagination:type_name -> queue_svc.PaginationParameters
-	15, // 12: queue_svc.SetItemStateResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
-	21, // 13: queue_svc.GetQueueItemsInput.queue:type_name -> queue.Identifier
-	20, // 14: queue_svc.GetQueueItemsInput.pagination:type_name -> queue_svc.PaginationParameters
-	20, // 15: queue_svc.GetQueueItemsResult.pagination:type_name -> queue_svc.PaginationParameters
-	15, // 16: queue_svc.GetQueueItemsResult.items:type_name -> queue_svc.IdentifiedQueueItemWithState
-	21, // 17: queue_svc.CancelItemInput.item:type_name -> queue.Identifier
-	21, // 18: queue_svc.IdentifiedQueueItemWithState.id:type_name -> queue.Identifier
-	22, // 19: queue_svc.IdentifiedQueueItemWithState.item:type_name -> queue.Item
-	23, // 20: queue_svc.IdentifiedQueueItemWithState.state:type_name -> queue.ItemState
-	24, // 21: queue_svc.IdentifiedQueueItemWithState.updated:type_name -> google.protobuf.Timestamp
-	21, // 22: queue_svc.EnqueueItemInput.queue:type_name -> queue.Identifier
-	22, // 23: queue_svc.EnqueueItemInput.item:type_name -> queue.Item
-	21, // 24: queue_svc.EnqueueItemResult.id:type_name -> queue.Identifier
-	21, // 25: queue_svc.CreateQueueResult.id:type_name -> queue.Identifier
-	21, // 26: queue_svc.PaginationParameters.next:type_name -> queue.Identifier
-	18, // 27: queue_svc.QueueService.CreateQueue:input_type -> queue_svc.CreateQueueInput
-	0,  // 28: queue_svc.QueueService.ListQueues:input_type -> queue_svc.ListQueuesInput
-	16, // 29: queue_svc.QueueService.EnqueueItem:input_type -> queue_svc.EnqueueItemInput
-	13, // 30: queue_svc.QueueService.CancelItem:input_type -> queue_svc.CancelItemInput
-	11, // 31: queue_svc.QueueService.GetQueueItems:input_type -> queue_svc.GetQueueItemsInput
-	5,  // 32: queue_svc.QueueService.GetFinishedItems:input_type -> queue_svc.GetFinishedItemsInput
-	9,  // 33: queue_svc.QueueService.SetItemState:input_type -> queue_svc.SetItemStateInput
-	7,  // 34: queue_svc.QueueService.ClaimNextItem:input_type -> queue_svc.ClaimNextItemInput
-	3,  // 35: queue_svc.QueueService.ClearHistory:input_type -> queue_svc.ClearHistoryInput
-	19, // 36: queue_svc.QueueService.CreateQueue:output_type -> queue_svc.CreateQueueResult
-	2,  // 37: queue_svc.QueueService.ListQueues:output_type -> queue_svc.ListQueuesResult
-	17, // 38: queue_svc.QueueService.EnqueueItem:output_type -> queue_svc.EnqueueItemResult
-	14, // 39: queue_svc.QueueService.CancelItem:output_type -> queue_svc.CancelItemResult
-	12, // 40: queue_svc.QueueService.GetQueueItems:output_type -> queue_svc.GetQueueItemsResult
-	6,  // 41: queue_svc.QueueService.GetFinishedItems:output_type -> queue_svc.GetFinishedItemsResult
-	10, // 42: queue_svc.QueueService.SetItemState:output_type -> queue_svc.SetItemStateResult
-	8,  // 43: queue_svc.QueueService.ClaimNextItem:output_type -> queue_svc.ClaimNextItemResult
-	4,  // 44: queue_svc.QueueService.ClearHistory:output_type -> queue_svc.ClearHistoryResult
-	36, // [36:45] is the sub-list for method output_type
-	27, // [27:36] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
-}
-
-func init() { file_queue_service_proto_init() }
-func file_queue_service_proto_init() {
-	if File_queue_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_queue_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuesInput); i {
+		file_queue_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkersInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1398,8 +6862,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueueResultItem); i {
+		file_queue_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkersResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1410,8 +6874,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListQueuesResult); i {
+		file_queue_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1422,8 +6886,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearHistoryInput); i {
+		file_queue_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,8 +6898,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClearHistoryResult); i {
+		file_queue_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,8 +6910,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFinishedItemsInput); i {
+		file_queue_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeyInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,8 +6922,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFinishedItemsResult); i {
+		file_queue_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,8 +6934,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimNextItemInput); i {
+		file_queue_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeKeyInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,8 +6946,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimNextItemResult); i {
+		file_queue_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeKeyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,8 +6958,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetItemStateInput); i {
+		file_queue_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateKeyInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,8 +6970,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetItemStateResult); i {
+		file_queue_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateKeyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,8 +6982,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetQueueItemsInput); i {
+		file_queue_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeysInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,8 +6994,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetQueueItemsResult); i {
+		file_queue_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKeysResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,8 +7006,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelItemInput); i {
+		file_queue_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,8 +7018,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelItemResult); i {
+		file_queue_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCategoryInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,8 +7030,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IdentifiedQueueItemWithState); i {
+		file_queue_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCategoryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,8 +7042,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueItemInput); i {
+		file_queue_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCategoriesInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,8 +7054,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnqueueItemResult); i {
+		file_queue_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCategoriesResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,8 +7066,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQueueInput); i {
+		file_queue_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCategoryInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1614,8 +7078,8 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateQueueResult); i {
+		file_queue_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCategoryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1626,8 +7090,20 @@ func file_queue_service_proto_init() {
 				return nil
 			}
 		}
-		file_queue_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationParameters); i {
+		file_queue_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCategoryInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_queue_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCategoryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1644,13 +7120,14 @@ func file_queue_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_queue_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      8,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_queue_service_proto_goTypes,
 		DependencyIndexes: file_queue_service_proto_depIdxs,
+		EnumInfos:         file_queue_service_proto_enumTypes,
 		MessageInfos:      file_queue_service_proto_msgTypes,
 	}.Build()
 	File_queue_service_proto = out.File
@@ -3,18 +3,37 @@ package queue_service
 import (
 	"context"
 	"errors"
+	"github.com/harryrose/godm/queue-service/auth"
 	db2 "github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/events"
 	"github.com/harryrose/godm/queue-service/functional"
 	"github.com/harryrose/godm/queue-service/queue"
 	"github.com/harryrose/godm/queue-service/rpc"
+	"github.com/harryrose/godm/queue-service/tracing"
+	"github.com/harryrose/godm/queue-service/workers"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
 	"time"
 )
 
 type Service struct {
-	DB *db2.Bolt
+	DB db2.Store
+	// Events receives a QueueEvent for every change made to a queue's items.  If nil, no
+	// events are published and WatchQueue is unavailable.
+	Events *events.Broker
+	// Workers tracks the downloaders which have registered.  If nil, the worker RPCs are
+	// unavailable.
+	Workers *workers.Registry
+	// Keys holds the API keys callers authenticate with.  If nil, the key RPCs are
+	// unavailable.
+	Keys *auth.Keys
+	// ArchiveDir is the directory DeleteQueue archives queues to.  If empty, queues can't be
+	// archived.
+	ArchiveDir string
 	rpc.UnimplementedQueueServiceServer
 }
 
@@ -23,7 +42,20 @@ func coerceDBError(err error) error {
 		return nil
 	}
 
+	var duplicate db2.ErrDuplicate
 	switch {
+	case errors.As(err, &duplicate):
+		// the existing item's id is given in the details, so that callers needn't parse it
+		// from the message
+		st, detailsErr := status.New(codes.AlreadyExists, err.Error()).WithDetails(&errdetails.ResourceInfo{
+			ResourceType: "item",
+			ResourceName: duplicate.ID,
+		})
+		if detailsErr != nil {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return st.Err()
+
 	case errors.As(err, &db2.ErrNotFound{}):
 		return status.Error(codes.NotFound, err.Error())
 
@@ -33,6 +65,9 @@ func coerceDBError(err error) error {
 	case errors.As(err, &db2.ErrConflict{}):
 		return status.Error(codes.AlreadyExists, err.Error())
 
+	case errors.As(err, &db2.ErrPrecondition{}):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -43,12 +78,105 @@ func (s *Service) CreateQueue(ctx context.Context, in *rpc.CreateQueueInput) (*r
 		return nil, status.Error(codes.InvalidArgument, "name must be provided and non-zero length")
 	}
 
-	_, err := s.DB.CreateQueue(in.Name)
+	settings, err := queueSettingsFromRPC(in.Config)
+	if err != nil {
+		return nil, err
+	}
+	if settings.RetryPolicy == nil {
+		settings.RetryPolicy, err = retryPolicyFromRPC(in.RetryPolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := s.DB.CreateQueue(in.Name, settings)
 	if err != nil {
 		return nil, coerceDBError(err)
 	}
 
-	return &rpc.CreateQueueResult{}, nil
+	return &rpc.CreateQueueResult{Id: &queue.Identifier{Id: created.Id}}, nil
+}
+
+func (s *Service) SetQueueRetryPolicy(ctx context.Context, in *rpc.SetQueueRetryPolicyInput) (*rpc.SetQueueRetryPolicyResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	policy, err := retryPolicyFromRPC(in.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.DB.SetQueueRetryPolicy(in.Queue.Id, policy); err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.SetQueueRetryPolicyResult{}, nil
+}
+
+func (s *Service) GetQueueConfig(ctx context.Context, in *rpc.GetQueueConfigInput) (*rpc.GetQueueConfigResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	meta, err := s.DB.GetQueue(in.Queue.Id)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.GetQueueConfigResult{Config: queueConfigToRPC(meta)}, nil
+}
+
+func (s *Service) UpdateQueueConfig(ctx context.Context, in *rpc.UpdateQueueConfigInput) (*rpc.UpdateQueueConfigResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	if in.Config == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "config must be provided")
+	}
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = queueConfigFields
+	}
+	for _, p := range paths {
+		if !slices.Contains(queueConfigFields, p) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown config field %q", p)
+		}
+	}
+	settings, err := queueSettingsFromRPC(in.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := s.DB.UpdateQueue(in.Queue.Id, func(meta *db2.Queue) error {
+		for _, p := range paths {
+			switch p {
+			case "claim_ttl":
+				meta.ClaimTTL = settings.ClaimTTL
+			case "max_concurrent_claims":
+				meta.MaxConcurrentClaims = settings.MaxConcurrentClaims
+			case "default_category":
+				meta.DefaultCategory = settings.DefaultCategory
+			case "default_destination_prefix":
+				meta.DefaultDestinationPrefix = settings.DefaultDestinationPrefix
+			case "default_rate_limit_bytes_per_second":
+				meta.DefaultRateLimit = settings.DefaultRateLimit
+			case "retry_policy":
+				meta.RetryPolicy = settings.RetryPolicy
+			case "history_retention":
+				meta.HistoryRetention = settings.HistoryRetention
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.UpdateQueueConfigResult{Config: queueConfigToRPC(meta)}, nil
 }
 
 func (s *Service) ListQueues(ctx context.Context, in *rpc.ListQueuesInput) (*rpc.ListQueuesResult, error) {
@@ -57,8 +185,13 @@ func (s *Service) ListQueues(ctx context.Context, in *rpc.ListQueuesInput) (*rpc
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	out := &rpc.ListQueuesResult{}
-	out.Queues = functional.Map(qs, func(q string) *rpc.ListQueueResultItem {
-		return &rpc.ListQueueResultItem{Name: q}
+	out.Queues = functional.Map(qs, func(q *db2.Queue) *rpc.ListQueueResultItem {
+		return &rpc.ListQueueResultItem{
+			Name:    q.Name,
+			Id:      &queue.Identifier{Id: q.Id},
+			Created: q.Timestamp,
+			Paused:  q.Paused,
+		}
 	})
 	return out, nil
 }
@@ -89,10 +222,51 @@ func (s *Service) EnqueueItem(ctx context.Context, in *rpc.EnqueueItemInput) (*r
 		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
 	}
 
-	res, err := s.DB.EnqueueItem(in.Queue.Id, in.Item.Source.Url, in.Item.Destination.Url, in.Item.Category.Id.Id)
+	if in.Item.Recurrence != "" {
+		if _, err := db2.ParseRecurrence(in.Item.Recurrence); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	policy, err := retryPolicyFromRPC(in.Item.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependsOn []string
+	for _, dep := range in.DependsOn {
+		if len(dep.GetId()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "depends_on ids must be non-empty")
+		}
+		dependsOn = append(dependsOn, dep.Id)
+	}
+
+	item := &db2.Item{
+		Source:           &db2.Target{Url: in.Item.Source.Url},
+		Destination:      &db2.Target{Url: in.Item.Destination.Url},
+		Category:         &db2.Category{Id: in.Item.GetCategory().GetId().GetId()},
+		Priority:         in.Item.Priority,
+		RetryPolicy:      policy,
+		NotBefore:        in.Item.NotBefore,
+		Recurrence:       in.Item.Recurrence,
+		DependsOn:        dependsOn,
+		DependencyPolicy: db2.DependencyPolicy(in.DependencyPolicy),
+		TraceParent:      tracing.TraceParent(ctx),
+	}
+	var res string
+	switch in.DuplicatePolicy {
+	case rpc.EnqueueItemInput_DUPLICATE_POLICY_REJECT, rpc.EnqueueItemInput_DUPLICATE_POLICY_RETURN_EXISTING:
+		res, err = s.DB.EnqueueUniqueItem(in.Queue.Id, item, duplicateCheckFromRPC(in))
+	default:
+		res, err = s.DB.EnqueueItem(in.Queue.Id, item)
+	}
+	var duplicate db2.ErrDuplicate
+	if errors.As(err, &duplicate) && in.DuplicatePolicy == rpc.EnqueueItemInput_DUPLICATE_POLICY_RETURN_EXISTING {
+		return &rpc.EnqueueItemResult{Id: &queue.Identifier{Id: duplicate.ID}, Duplicate: true}, nil
+	}
 	if err != nil {
 		return nil, coerceDBError(err)
 	}
+	s.publish(in.Queue.Id, rpc.QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED, activeItemToRPC(item))
 	return &rpc.EnqueueItemResult{Id: &queue.Identifier{Id: res}}, nil
 }
 
@@ -106,8 +280,112 @@ func (s *Service) CancelItem(ctx context.Context, in *rpc.CancelItemInput) (*rpc
 	if len(in.Item.Id) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "item id must be provided and non-empty")
 	}
-	err := s.DB.CancelItem(in.Item.Id)
-	return &rpc.CancelItemResult{}, coerceDBError(err)
+	ch, err := s.DB.CancelItem(in.Item.Id)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	s.publishForItem(in.Item.Id, rpc.QueueEvent_QUEUE_EVENT_TYPE_CANCELLED, &queue.ItemState{
+		State:   queue.ItemState_ITEM_STATE_FAILED,
+		Message: "cancelled by user",
+	})
+	s.publishChanges(ch)
+	return &rpc.CancelItemResult{}, nil
+}
+
+func (s *Service) MoveItem(ctx context.Context, in *rpc.MoveItemInput) (*rpc.MoveItemResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetItem().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "item id must be provided and non-empty")
+	}
+	if len(in.GetRelativeTo().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "relative_to id must be provided and non-empty")
+	}
+
+	var after bool
+	switch in.Placement {
+	case rpc.MoveItemInput_PLACEMENT_BEFORE:
+		after = false
+	case rpc.MoveItemInput_PLACEMENT_AFTER:
+		after = true
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "placement must be provided and not unspecified")
+	}
+
+	item, err := s.DB.MoveItem(in.Item.Id, in.RelativeTo.Id, after)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	s.publishMoved(item)
+	return &rpc.MoveItemResult{}, nil
+}
+
+func (s *Service) MoveToFront(ctx context.Context, in *rpc.MoveToFrontInput) (*rpc.MoveToFrontResult, error) {
+	if len(in.GetItem().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "item id must be provided and non-empty")
+	}
+	item, err := s.DB.MoveToFront(in.Item.Id)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	s.publishMoved(item)
+	return &rpc.MoveToFrontResult{}, nil
+}
+
+func (s *Service) MoveToBack(ctx context.Context, in *rpc.MoveToBackInput) (*rpc.MoveToBackResult, error) {
+	if len(in.GetItem().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "item id must be provided and non-empty")
+	}
+	item, err := s.DB.MoveToBack(in.Item.Id)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	s.publishMoved(item)
+	return &rpc.MoveToBackResult{}, nil
+}
+
+func (s *Service) UpdateItem(ctx context.Context, in *rpc.UpdateItemInput) (*rpc.UpdateItemResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetItem().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "item id must be provided and non-empty")
+	}
+	if in.Changes == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "changes must be provided")
+	}
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = itemFields
+	}
+
+	update := db2.ItemUpdate{NextAttempt: in.NextAttempt}
+	for _, p := range paths {
+		switch p {
+		case "source":
+			if len(in.Changes.GetSource().GetUrl()) == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "item source url must be provided and non-empty")
+			}
+			update.Source = &db2.Target{Url: in.Changes.Source.Url}
+		case "destination":
+			if len(in.Changes.GetDestination().GetUrl()) == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "item destination url must be provided and non-empty")
+			}
+			update.Destination = &db2.Target{Url: in.Changes.Destination.Url}
+		case "category":
+			update.Category = &db2.Category{Id: in.Changes.GetCategory().GetId().GetId()}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown item field %q", p)
+		}
+	}
+
+	item, err := s.DB.UpdateItem(in.Item.Id, update)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	s.publishActive(rpc.QueueEvent_QUEUE_EVENT_TYPE_UPDATED, item)
+	return &rpc.UpdateItemResult{Item: activeItemToRPC(item)}, nil
 }
 
 func (s *Service) GetFinishedItems(ctx context.Context, in *rpc.GetFinishedItemsInput) (*rpc.GetFinishedItemsResult, error) {
@@ -121,57 +399,23 @@ func (s *Service) GetFinishedItems(ctx context.Context, in *rpc.GetFinishedItems
 		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided")
 	}
 
-	limit := uint(0)
-	startKey := ""
-
-	items, next, err := s.DB.GetFinishedItems(in.Queue.Id, startKey, limit)
+	query := itemQueryFromRPC(in.Pagination, in.Filter, in.Sort)
+	items, next, err := s.DB.GetFinishedItems(in.Queue.Id, query)
 	if err != nil {
 		return nil, coerceDBError(err)
 	}
 	out := rpc.GetFinishedItemsResult{
 		Items: make([]*rpc.IdentifiedQueueItemWithState, len(items)),
 		Pagination: &rpc.PaginationParameters{
-			Limit: uint32(limit),
+			Limit: uint32(query.Limit()),
 			Next: &queue.Identifier{
 				Id: next,
 			},
 		},
 	}
 
-	for idx, _ := range items {
-		var itemState queue.ItemState_State
-		switch items[idx].State {
-		case db2.FinishedItem_ITEM_STATE_SUCCESS:
-			itemState = queue.ItemState_ITEM_STATE_COMPLETE
-		case db2.FinishedItem_ITEM_STATE_FAILED:
-			itemState = queue.ItemState_ITEM_STATE_FAILED
-		case db2.FinishedItem_ITEM_STATE_CANCELLED:
-			itemState = queue.ItemState_ITEM_STATE_FAILED
-		default:
-			itemState = queue.ItemState_ITEM_STATE_UNSPECIFIED
-		}
-
-		out.Items[idx] = &rpc.IdentifiedQueueItemWithState{
-			Id: &queue.Identifier{
-				Id: items[idx].Item.Id,
-			},
-			Item: &queue.Item{
-				Source: &queue.Target{
-					Url: items[idx].Item.Source.Url,
-				},
-				Destination: &queue.Target{
-					Url: items[idx].Item.Destination.Url,
-				},
-				Category: &queue.Category{Id: &queue.Identifier{Id: items[idx].Item.Category.Id}},
-			},
-			State: &queue.ItemState{
-				State:           itemState,
-				TotalSizeBytes:  items[idx].TotalSizeBytes,
-				DownloadedBytes: items[idx].DownloadedBytes,
-				Message:         "",
-			},
-			Updated: items[idx].Timestamp,
-		}
+	for idx := range items {
+		out.Items[idx] = finishedItemToRPC(items[idx])
 	}
 	return &out, nil
 }
@@ -187,49 +431,22 @@ func (s *Service) GetQueueItems(ctx context.Context, in *rpc.GetQueueItemsInput)
 		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided")
 	}
 
-	limit := uint(0)
-	startKey := ""
-
-	items, next, err := s.DB.GetQueueItems(in.Queue.Id, startKey, limit)
+	query := itemQueryFromRPC(in.Pagination, in.Filter, in.Sort)
+	items, next, err := s.DB.GetQueueItems(in.Queue.Id, query)
 	if err != nil {
 		return nil, coerceDBError(err)
 	}
 	out := rpc.GetQueueItemsResult{
 		Items: make([]*rpc.IdentifiedQueueItemWithState, len(items)),
 		Pagination: &rpc.PaginationParameters{
-			Limit: uint32(limit),
+			Limit: uint32(query.Limit()),
 			Next: &queue.Identifier{
 				Id: next,
 			},
 		},
 	}
-	for idx, _ := range items {
-		state := queue.ItemState_ITEM_STATE_QUEUED
-		if items[idx].ClaimExpiry.AsTime().After(time.Now()) {
-			state = queue.ItemState_ITEM_STATE_DOWNLOADING
-		}
-
-		out.Items[idx] = &rpc.IdentifiedQueueItemWithState{
-			Id: &queue.Identifier{
-				Id: items[idx].Id,
-			},
-			Item: &queue.Item{
-				Source: &queue.Target{
-					Url: items[idx].Source.Url,
-				},
-				Destination: &queue.Target{
-					Url: items[idx].Destination.Url,
-				},
-				Category: &queue.Category{Id: &queue.Identifier{Id: items[idx].Category.Id}},
-			},
-			State: &queue.ItemState{
-				State:           state,
-				TotalSizeBytes:  items[idx].TotalSizeBytes,
-				DownloadedBytes: items[idx].DownloadedBytes,
-				Message:         "",
-			},
-			Updated: timestamppb.New(time.Now()),
-		}
+	for idx := range items {
+		out.Items[idx] = activeItemToRPC(items[idx])
 	}
 	return &out, nil
 }
@@ -244,7 +461,7 @@ func (s *Service) ClaimNextItem(ctx context.Context, in *rpc.ClaimNextItemInput)
 	if len(in.Queue.Id) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
 	}
-	item, err := s.DB.ClaimNextItem(in.Queue.Id)
+	item, err := s.DB.ClaimNextItem(in.Queue.Id, in.WorkerId)
 	if err != nil {
 		return nil, coerceDBError(err)
 	}
@@ -253,20 +470,28 @@ func (s *Service) ClaimNextItem(ctx context.Context, in *rpc.ClaimNextItemInput)
 		return &rpc.ClaimNextItemResult{}, nil
 	}
 
+	var category *rpc.CategoryConfig
+	if item.CategorySettings != nil {
+		category = categoryToRPC(item.CategorySettings)
+	}
+
+	if s.Workers != nil && in.WorkerId != "" {
+		s.Workers.Heartbeat(in.WorkerId, item.Id)
+	}
+
+	claimed := activeItemToRPC(item.Item)
+	s.publish(in.Queue.Id, rpc.QueueEvent_QUEUE_EVENT_TYPE_CLAIMED, claimed)
+
 	return &rpc.ClaimNextItemResult{
-		Id: &queue.Identifier{
-			Id: item.Id,
-		},
-		Item: &queue.Item{
-			Source:      &queue.Target{Url: item.Source.Url},
-			Destination: &queue.Target{Url: item.Destination.Url},
-			Category: &queue.Category{
-				Id: &queue.Identifier{
-					Id: item.Category.Id,
-				},
-			},
-		},
-	}, coerceDBError(err)
+		Id:                      claimed.Id,
+		Item:                    claimed.Item,
+		RateLimitBytesPerSecond: item.RateLimit,
+		ClaimTtl:                durationpb.New(item.ClaimTTL),
+		LeaseToken:              item.Lease,
+		TraceParent:             item.TraceParent,
+		ResumeFromBytes:         item.ResumeFrom,
+		Category:                category,
+	}, nil
 }
 
 func (s *Service) SetItemState(ctx context.Context, in *rpc.SetItemStateInput) (*rpc.SetItemStateResult, error) {
@@ -285,8 +510,30 @@ func (s *Service) SetItemState(ctx context.Context, in *rpc.SetItemStateInput) (
 	if in.State.State == queue.ItemState_ITEM_STATE_UNSPECIFIED {
 		return nil, status.Error(codes.InvalidArgument, "state state must be provided and not unspecified")
 	}
-	err := s.DB.SetItemState(in.Item.Id, in.State.State, in.State.DownloadedBytes, in.State.TotalSizeBytes, errors.New(in.State.Message))
-	return &rpc.SetItemStateResult{}, coerceDBError(err)
+	if in.LeaseToken == 0 {
+		return nil, status.Error(codes.InvalidArgument, "lease token must be provided")
+	}
+	ch, err := s.DB.SetItemState(in.Item.Id, in.LeaseToken, in.State.State, in.State.DownloadedBytes, in.State.TotalSizeBytes, db2.FailureClass(in.State.FailureClass), errors.New(in.State.Message))
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	for i, item := range ch.Updated {
+		if item.Id == in.Item.Id {
+			// the item failed, but will be attempted again
+			s.publishForRetry(item, in.State)
+			ch.Updated = slices.Delete(ch.Updated, i, i+1)
+			s.publishChanges(ch)
+			return &rpc.SetItemStateResult{}, nil
+		}
+	}
+
+	eventType := rpc.QueueEvent_QUEUE_EVENT_TYPE_UPDATED
+	if in.State.State == queue.ItemState_ITEM_STATE_COMPLETE || in.State.State == queue.ItemState_ITEM_STATE_FAILED {
+		eventType = rpc.QueueEvent_QUEUE_EVENT_TYPE_FINISHED
+	}
+	s.publishForItem(in.Item.Id, eventType, in.State)
+	s.publishChanges(ch)
+	return &rpc.SetItemStateResult{}, nil
 }
 
 func (s *Service) ClearHistory(ctx context.Context, in *rpc.ClearHistoryInput) (*rpc.ClearHistoryResult, error) {
@@ -300,6 +547,420 @@ func (s *Service) ClearHistory(ctx context.Context, in *rpc.ClearHistoryInput) (
 		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
 	}
 
-	err := s.DB.ClearHistory(in.Queue.Id)
-	return &rpc.ClearHistoryResult{}, coerceDBError(err)
+	var filter *db2.ItemFilter
+	if in.Filter != nil {
+		query := itemQueryFromRPC(nil, in.Filter, nil)
+		filter = &query.Filter
+	}
+	removed, err := s.DB.ClearHistory(in.Queue.Id, filter)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	return &rpc.ClearHistoryResult{Removed: uint32(removed)}, nil
+}
+
+func (s *Service) RequeueItems(ctx context.Context, in *rpc.RequeueItemsInput) (*rpc.RequeueItemsResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.GetQueue().GetId()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	if len(in.Items) == 0 && in.Filter == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "items or filter must be provided")
+	}
+
+	var ids []string
+	for _, item := range in.Items {
+		if len(item.GetId()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "item ids must be non-empty")
+		}
+		ids = append(ids, item.Id)
+	}
+	var filter *db2.ItemFilter
+	if in.Filter != nil {
+		query := itemQueryFromRPC(nil, in.Filter, nil)
+		filter = &query.Filter
+	}
+
+	items, err := s.DB.RequeueItems(in.Queue.Id, ids, filter)
+	if err != nil {
+		return nil, coerceDBError(err)
+	}
+	out := &rpc.RequeueItemsResult{}
+	for _, item := range items {
+		s.publishActive(rpc.QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED, item)
+		out.Items = append(out.Items, activeItemToRPC(item))
+	}
+	return out, nil
+}
+
+// publish sends an event describing a change to an item in the given queue to any watchers.
+func (s *Service) publish(queueID string, eventType rpc.QueueEvent_Type, item *rpc.IdentifiedQueueItemWithState) {
+	if s.Events == nil {
+		return
+	}
+	s.Events.Publish(db2.QueueID(queueID), &rpc.QueueEvent{
+		Type: eventType,
+		Item: item,
+	})
+}
+
+// publishMoved sends an event reporting that the item's position in its queue has changed.
+func (s *Service) publishMoved(item *db2.Item) {
+	s.publishActive(rpc.QueueEvent_QUEUE_EVENT_TYPE_MOVED, item)
+}
+
+// publishChanges sends events describing the changes that an operation caused to other items.
+func (s *Service) publishChanges(ch *db2.Changes) {
+	for _, item := range ch.Enqueued {
+		s.publishActive(rpc.QueueEvent_QUEUE_EVENT_TYPE_ENQUEUED, item)
+	}
+	for _, item := range ch.Updated {
+		s.publishActive(rpc.QueueEvent_QUEUE_EVENT_TYPE_UPDATED, item)
+	}
+	for _, item := range ch.Finished {
+		queueID, err := db2.QueueIDFromItemID(item.Item.Id)
+		if err != nil {
+			continue
+		}
+		s.publish(queueID, rpc.QueueEvent_QUEUE_EVENT_TYPE_FINISHED, finishedItemToRPC(item))
+	}
+}
+
+// publishActive sends an event describing the state of an item in the active queue.
+func (s *Service) publishActive(eventType rpc.QueueEvent_Type, item *db2.Item) {
+	queueID, err := db2.QueueIDFromItemID(item.Id)
+	if err != nil {
+		return
+	}
+	s.publish(queueID, eventType, activeItemToRPC(item))
+}
+
+// publishForRetry sends an event reporting that a failed item has been rescheduled.
+func (s *Service) publishForRetry(item *db2.Item, failure *queue.ItemState) {
+	queueID, err := db2.QueueIDFromItemID(item.Id)
+	if err != nil {
+		return
+	}
+	retry := activeItemToRPC(item)
+	retry.State.Message = failure.Message
+	retry.State.FailureClass = failure.FailureClass
+	s.publish(queueID, rpc.QueueEvent_QUEUE_EVENT_TYPE_UPDATED, retry)
+}
+
+// publishForItem sends an event describing the new state of the item with the given id.
+func (s *Service) publishForItem(id string, eventType rpc.QueueEvent_Type, state *queue.ItemState) {
+	queueID, err := db2.QueueIDFromItemID(id)
+	if err != nil {
+		return
+	}
+	s.publish(queueID, eventType, &rpc.IdentifiedQueueItemWithState{
+		Id:      &queue.Identifier{Id: id},
+		State:   state,
+		Updated: timestamppb.Now(),
+	})
+}
+
+// itemQueryFromRPC converts the parameters of a listing request into a database query.
+func itemQueryFromRPC(pagination *rpc.PaginationParameters, filter *rpc.ItemFilter, sort *rpc.ItemSort) db2.ItemQuery {
+	query := db2.ItemQuery{
+		Filter: db2.ItemFilter{
+			States:            filter.GetStates(),
+			Category:          filter.GetCategory(),
+			SourceHost:        filter.GetSourceHost(),
+			DestinationPrefix: filter.GetDestinationPrefix(),
+		},
+		Descending: sort.GetDescending(),
+		Cursor:     pagination.GetNext().GetId(),
+		PageSize:   uint(pagination.GetLimit()),
+	}
+	if filter.GetUpdatedBefore() != nil {
+		query.Filter.UpdatedBefore = filter.UpdatedBefore.AsTime()
+	}
+	if filter.GetUpdatedAfter() != nil {
+		query.Filter.UpdatedAfter = filter.UpdatedAfter.AsTime()
+	}
+
+	switch sort.GetField() {
+	case rpc.ItemSort_SORT_FIELD_UPDATED:
+		query.Sort = db2.SortUpdated
+	case rpc.ItemSort_SORT_FIELD_SOURCE:
+		query.Sort = db2.SortSource
+	case rpc.ItemSort_SORT_FIELD_DESTINATION:
+		query.Sort = db2.SortDestination
+	case rpc.ItemSort_SORT_FIELD_CATEGORY:
+		query.Sort = db2.SortCategory
+	case rpc.ItemSort_SORT_FIELD_TOTAL_SIZE:
+		query.Sort = db2.SortTotalSize
+	default:
+		query.Sort = db2.SortDefault
+	}
+	return query
+}
+
+// retryPolicyFromRPC validates a retry policy supplied by a client and converts it for storage.
+func retryPolicyFromRPC(policy *queue.RetryPolicy) (*db2.RetryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.InitialBackoff.AsDuration() < 0 || policy.MaxBackoff.AsDuration() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retry policy backoffs must not be negative")
+	}
+	if policy.Multiplier < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retry policy multiplier must not be negative")
+	}
+	return &db2.RetryPolicy{
+		MaxAttempts:    policy.MaxAttempts,
+		InitialBackoff: policy.InitialBackoff,
+		MaxBackoff:     policy.MaxBackoff,
+		Multiplier:     policy.Multiplier,
+		RetryOn: functional.Map(policy.RetryOn, func(c queue.FailureClass) db2.FailureClass {
+			return db2.FailureClass(c)
+		}),
+	}, nil
+}
+
+// duplicateCheckFromRPC converts the duplicate matching parameters of an EnqueueItem request
+// into a database check.
+func duplicateCheckFromRPC(in *rpc.EnqueueItemInput) db2.DuplicateCheck {
+	check := db2.DuplicateCheck{History: in.DuplicateHistory}
+	switch in.DuplicateMatch {
+	case rpc.EnqueueItemInput_DUPLICATE_MATCH_DESTINATION:
+		check.Destination = true
+	case rpc.EnqueueItemInput_DUPLICATE_MATCH_SOURCE_AND_DESTINATION:
+		check.Source, check.Destination = true, true
+	default:
+		check.Source = true
+	}
+	return check
+}
+
+// itemFields lists the paths of queue.Item which UpdateItem accepts in its update mask.
+var itemFields = []string{
+	"source",
+	"destination",
+	"category",
+}
+
+// queueConfigFields lists the paths of rpc.QueueConfig which UpdateQueueConfig accepts in its
+// update mask.
+var queueConfigFields = []string{
+	"claim_ttl",
+	"max_concurrent_claims",
+	"default_category",
+	"default_destination_prefix",
+	"default_rate_limit_bytes_per_second",
+	"retry_policy",
+	"history_retention",
+}
+
+// queueSettingsFromRPC validates a queue's config supplied by a client and converts it to the
+// settings stored in the queue's record.
+func queueSettingsFromRPC(config *rpc.QueueConfig) (*db2.Queue, error) {
+	if config == nil {
+		return &db2.Queue{}, nil
+	}
+	if ttl := config.ClaimTtl.AsDuration(); ttl != 0 && ttl < db2.MinClaimTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "claim ttl must be at least %v", db2.MinClaimTTL)
+	}
+	policy, err := retryPolicyFromRPC(config.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	retention, err := historyRetentionFromRPC(config.HistoryRetention)
+	if err != nil {
+		return nil, err
+	}
+	return &db2.Queue{
+		ClaimTTL:                 config.ClaimTtl,
+		MaxConcurrentClaims:      config.MaxConcurrentClaims,
+		DefaultCategory:          config.DefaultCategory,
+		DefaultDestinationPrefix: config.DefaultDestinationPrefix,
+		DefaultRateLimit:         config.DefaultRateLimitBytesPerSecond,
+		RetryPolicy:              policy,
+		HistoryRetention:         retention,
+	}, nil
+}
+
+// historyRetentionFromRPC validates a history retention policy supplied by a client and converts
+// it for storage.
+func historyRetentionFromRPC(retention *rpc.HistoryRetention) (*db2.HistoryRetention, error) {
+	if retention == nil {
+		return nil, nil
+	}
+	if retention.MaxAge.AsDuration() < 0 || retention.FailedMaxAge.AsDuration() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "history retention ages must not be negative")
+	}
+	if failed := retention.FailedMaxAge.AsDuration(); failed > 0 && failed < retention.MaxAge.AsDuration() {
+		return nil, status.Errorf(codes.InvalidArgument, "failed items must be kept for at least as long as other items")
+	}
+	if retention.FailedMaxAge.AsDuration() > 0 && retention.MaxAge.AsDuration() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "a failed item age can only be set with an age for other items, which are otherwise kept for ever")
+	}
+	return &db2.HistoryRetention{
+		MaxEntries:   retention.MaxEntries,
+		MaxAge:       retention.MaxAge,
+		FailedMaxAge: retention.FailedMaxAge,
+	}, nil
+}
+
+func historyRetentionToRPC(retention *db2.HistoryRetention) *rpc.HistoryRetention {
+	if retention == nil {
+		return nil
+	}
+	return &rpc.HistoryRetention{
+		MaxEntries:   retention.MaxEntries,
+		MaxAge:       retention.MaxAge,
+		FailedMaxAge: retention.FailedMaxAge,
+	}
+}
+
+func queueConfigToRPC(meta *db2.Queue) *rpc.QueueConfig {
+	return &rpc.QueueConfig{
+		ClaimTtl:                       durationpb.New(meta.ClaimTTLOrDefault()),
+		MaxConcurrentClaims:            meta.MaxConcurrentClaims,
+		DefaultCategory:                meta.DefaultCategory,
+		DefaultDestinationPrefix:       meta.DefaultDestinationPrefix,
+		DefaultRateLimitBytesPerSecond: meta.DefaultRateLimit,
+		RetryPolicy:                    retryPolicyToRPC(meta.RetryPolicy),
+		HistoryRetention:               historyRetentionToRPC(meta.HistoryRetention),
+	}
+}
+
+func retryPolicyToRPC(policy *db2.RetryPolicy) *queue.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &queue.RetryPolicy{
+		MaxAttempts:    policy.MaxAttempts,
+		InitialBackoff: policy.InitialBackoff,
+		MaxBackoff:     policy.MaxBackoff,
+		Multiplier:     policy.Multiplier,
+		RetryOn: functional.Map(policy.RetryOn, func(c db2.FailureClass) queue.FailureClass {
+			return queue.FailureClass(c)
+		}),
+	}
+}
+
+func attemptsToRPC(attempts []*db2.Attempt) []*queue.Attempt {
+	return functional.Map(attempts, func(a *db2.Attempt) *queue.Attempt {
+		return &queue.Attempt{
+			Finished:        a.Finished,
+			FailureClass:    queue.FailureClass(a.FailureClass),
+			Message:         a.Message,
+			DownloadedBytes: a.DownloadedBytes,
+		}
+	})
+}
+
+func identifiersToRPC(ids []string) []*queue.Identifier {
+	return functional.Map(ids, func(id string) *queue.Identifier {
+		return &queue.Identifier{Id: id}
+	})
+}
+
+func previousRunToRPC(item *db2.Item) *queue.Identifier {
+	if item.PreviousRun == "" {
+		return nil
+	}
+	return &queue.Identifier{Id: item.PreviousRun}
+}
+
+// finalFailureClass returns the class of failure which caused a failed item to finish.
+func finalFailureClass(item *db2.FinishedItem) queue.FailureClass {
+	attempts := item.Item.GetAttempts()
+	if item.State != db2.FinishedItem_ITEM_STATE_FAILED || len(attempts) == 0 {
+		return queue.FailureClass_FAILURE_CLASS_UNSPECIFIED
+	}
+	return queue.FailureClass(attempts[len(attempts)-1].FailureClass)
+}
+
+func activeItemToRPC(item *db2.Item) *rpc.IdentifiedQueueItemWithState {
+	now := time.Now()
+	state := item.State(now)
+	claimedBy := ""
+	if state == queue.ItemState_ITEM_STATE_DOWNLOADING {
+		claimedBy = item.ClaimedBy
+	}
+	return &rpc.IdentifiedQueueItemWithState{
+		Id: &queue.Identifier{
+			Id: item.Id,
+		},
+		Item: &queue.Item{
+			Source: &queue.Target{
+				Url: item.Source.Url,
+			},
+			Destination: &queue.Target{
+				Url: item.Destination.Url,
+			},
+			Category:    &queue.Category{Id: &queue.Identifier{Id: item.Category.Id}},
+			Priority:    item.Priority,
+			RetryPolicy: retryPolicyToRPC(item.RetryPolicy),
+			NotBefore:   item.NotBefore,
+			Recurrence:  item.Recurrence,
+		},
+		State: &queue.ItemState{
+			State:           state,
+			TotalSizeBytes:  item.TotalSizeBytes,
+			DownloadedBytes: item.DownloadedBytes,
+			Message:         item.BlockedReason(),
+		},
+		Updated:      item.Updated,
+		Attempts:     attemptsToRPC(item.Attempts),
+		NotBefore:    item.NotBefore,
+		PreviousRun:  previousRunToRPC(item),
+		DependsOn:    identifiersToRPC(item.DependsOn),
+		ClaimedBy:    claimedBy,
+		TraceId:      tracing.TraceID(item.TraceParent),
+		NextSource:   nextSourceToRPC(item.NextSource),
+		RequeuedFrom: requeuedFromToRPC(item),
+	}
+}
+
+func requeuedFromToRPC(item *db2.Item) *queue.Identifier {
+	if item.RequeuedFrom == "" {
+		return nil
+	}
+	return &queue.Identifier{Id: item.RequeuedFrom}
+}
+
+func nextSourceToRPC(source *db2.Target) *queue.Target {
+	if source == nil {
+		return nil
+	}
+	return &queue.Target{Url: source.Url}
+}
+
+func finishedItemToRPC(item *db2.FinishedItem) *rpc.IdentifiedQueueItemWithState {
+	return &rpc.IdentifiedQueueItemWithState{
+		Id: &queue.Identifier{
+			Id: item.Item.Id,
+		},
+		Item: &queue.Item{
+			Source: &queue.Target{
+				Url: item.Item.Source.Url,
+			},
+			Destination: &queue.Target{
+				Url: item.Item.Destination.Url,
+			},
+			Category:    &queue.Category{Id: &queue.Identifier{Id: item.Item.Category.Id}},
+			Priority:    item.Item.Priority,
+			RetryPolicy: retryPolicyToRPC(item.Item.RetryPolicy),
+			Recurrence:  item.Item.Recurrence,
+		},
+		State: &queue.ItemState{
+			State:           item.ItemState(),
+			TotalSizeBytes:  item.TotalSizeBytes,
+			DownloadedBytes: item.DownloadedBytes,
+			Message:         item.Message,
+			FailureClass:    finalFailureClass(item),
+		},
+		Updated:      item.Timestamp,
+		Attempts:     attemptsToRPC(item.Item.Attempts),
+		PreviousRun:  previousRunToRPC(item.Item),
+		DependsOn:    identifiersToRPC(item.Item.DependsOn),
+		TraceId:      tracing.TraceID(item.Item.TraceParent),
+		RequeuedFrom: requeuedFromToRPC(item.Item),
+	}
 }
@@ -0,0 +1,27 @@
+// Package tracing links the spans of an item's download to the call which enqueued it.  The
+// export of traces is configured by the log module's tracing package.
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceParent returns the W3C traceparent of the span in the context, or an empty string if the
+// context isn't being traced.
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier["traceparent"]
+}
+
+// TraceID returns the trace id in a W3C traceparent, or an empty string if it isn't valid.
+func TraceID(traceParent string) string {
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{"traceparent": traceParent})
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/trace"
+	"testing"
+)
+
+func TestTraceParent(t *testing.T) {
+	if got := TraceParent(context.Background()); got != "" {
+		t.Errorf("expected no traceparent without a span, got %q", got)
+	}
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	expected := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	if got := TraceParent(ctx); got != expected {
+		t.Errorf("expected traceparent %q, got %q", expected, got)
+	}
+	if got := TraceID(TraceParent(ctx)); got != traceID.String() {
+		t.Errorf("expected the trace id to survive a round trip, got %q", got)
+	}
+}
+
+func TestTraceID(t *testing.T) {
+	tests := map[string]string{
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01": "4bf92f3577b34da6a3ce929d0e0e4736",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00": "4bf92f3577b34da6a3ce929d0e0e4736",
+		"":                  "",
+		"not a traceparent": "",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01": "",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01": "",
+	}
+	for traceParent, expected := range tests {
+		if got := TraceID(traceParent); got != expected {
+			t.Errorf("expected the trace id of %q to be %q, got %q", traceParent, expected, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package queue_service
+
+import (
+	db2 "github.com/harryrose/godm/queue-service/db"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) WatchQueue(in *rpc.WatchQueueInput, stream rpc.QueueService_WatchQueueServer) error {
+	if in == nil {
+		return status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if in.Queue == nil {
+		return status.Errorf(codes.InvalidArgument, "queue must be provided")
+	}
+	if len(in.Queue.Id) == 0 {
+		return status.Errorf(codes.InvalidArgument, "queue id must be provided and non-empty")
+	}
+	if s.Events == nil {
+		return status.Errorf(codes.Unimplemented, "queue events are not enabled")
+	}
+
+	// subscribe before taking the snapshot so that nothing that happens while the snapshot
+	// is being read is missed.  changes made in that window may be reported twice, which is
+	// harmless as each event carries the full state of the item.
+	sub, head, backlog, resumed := s.Events.Subscribe(db2.QueueID(in.Queue.Id), in.ResumeFrom)
+	defer s.Events.Unsubscribe(sub)
+
+	if !resumed {
+		if err := s.sendSnapshot(stream, in.Queue.Id, head); err != nil {
+			return err
+		}
+	}
+	for _, ev := range backlog {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+
+		case ev, ok := <-sub.C():
+			if !ok {
+				if sub.Lagged() {
+					return status.Error(codes.ResourceExhausted, "watcher fell behind; resume from the last sequence received")
+				}
+				if sub.Removed() {
+					return status.Error(codes.NotFound, "the queue was deleted")
+				}
+				return status.Error(codes.Unavailable, "the service is shutting down")
+			}
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Service) sendSnapshot(stream rpc.QueueService_WatchQueueServer, queueID string, head uint64) error {
+	next := ""
+	for {
+		items, nextCursor, err := s.DB.GetQueueItems(queueID, db2.ItemQuery{Cursor: next, PageSize: db2.MaxPageSize})
+		if err != nil {
+			return coerceDBError(err)
+		}
+		for _, item := range items {
+			err := stream.Send(&rpc.QueueEvent{
+				Sequence:  head,
+				Type:      rpc.QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT,
+				Item:      activeItemToRPC(item),
+				Timestamp: timestamppb.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if nextCursor == "" {
+			break
+		}
+		next = nextCursor
+	}
+
+	return stream.Send(&rpc.QueueEvent{
+		Sequence:  head,
+		Type:      rpc.QueueEvent_QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE,
+		Timestamp: timestamppb.Now(),
+	})
+}
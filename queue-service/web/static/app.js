@@ -0,0 +1,435 @@
+// The GoDM web interface.  Every request goes through the HTTP gateway, served from the same
+// origin at /v1/, with the key the user signed in with.
+"use strict";
+
+const keyStorage = "godm.key";
+const queueStorage = "godm.queue";
+const historyPageSize = 50;
+const reconnectDelayMs = 2000;
+
+const $ = (id) => document.getElementById(id);
+
+const stateNames = {
+  ITEM_STATE_QUEUED: "Queued",
+  ITEM_STATE_DOWNLOADING: "Downloading",
+  ITEM_STATE_FAILED: "Failed",
+  ITEM_STATE_COMPLETE: "Complete",
+  ITEM_STATE_WAITING: "Waiting",
+  ITEM_STATE_BLOCKED: "Blocked",
+};
+
+let view = "active";
+let watch = null;
+let historyCursor = "";
+
+class Unauthenticated extends Error {}
+
+// call makes a request to the given RPC, and returns its result.
+async function call(method, input) {
+  const res = await fetch("/v1/" + method, {
+    method: "POST",
+    headers: {"Authorization": "Bearer " + localStorage.getItem(keyStorage), "Content-Type": "application/json"},
+    body: JSON.stringify(input || {}),
+  });
+  if (res.status === 401) {
+    throw new Unauthenticated();
+  }
+  const body = await res.json();
+  if (!res.ok) {
+    throw new Error(body.message || res.statusText);
+  }
+  return body;
+}
+
+// stream makes a request to the given streaming RPC, and calls onResult with each result until
+// the stream ends or the signal is aborted.
+async function stream(method, input, signal, onResult) {
+  const res = await fetch("/v1/" + method, {
+    method: "POST",
+    headers: {"Authorization": "Bearer " + localStorage.getItem(keyStorage), "Content-Type": "application/json"},
+    body: JSON.stringify(input || {}),
+    signal,
+  });
+  if (res.status === 401) {
+    throw new Unauthenticated();
+  }
+  if (!res.ok) {
+    const body = await res.json();
+    throw new Error(body.message || res.statusText);
+  }
+  const reader = res.body.pipeThrough(new TextDecoderStream()).getReader();
+  let buffered = "";
+  for (;;) {
+    const {value, done} = await reader.read();
+    if (done) {
+      return;
+    }
+    buffered += value;
+    let newline;
+    while ((newline = buffered.indexOf("\n")) >= 0) {
+      const line = JSON.parse(buffered.slice(0, newline));
+      buffered = buffered.slice(newline + 1);
+      if (line.error) {
+        throw new Error(line.error.message);
+      }
+      onResult(line.result);
+    }
+  }
+}
+
+function showError(err) {
+  if (err instanceof Unauthenticated) {
+    signOut();
+    showError(new Error("The key was not accepted."));
+    return;
+  }
+  if (err && err.name === "AbortError") {
+    return;
+  }
+  $("error").textContent = err ? err.message : "";
+  $("error").hidden = !err;
+}
+
+function formatBytes(n) {
+  n = Number(n || 0);
+  const units = ["B", "KiB", "MiB", "GiB", "TiB"];
+  let i = 0;
+  while (n >= 1024 && i < units.length - 1) {
+    n /= 1024;
+    i++;
+  }
+  return (i === 0 ? n : n.toFixed(1)) + " " + units[i];
+}
+
+function currentQueue() {
+  return $("queue").value;
+}
+
+// Signing in and out
+
+function signOut() {
+  localStorage.removeItem(keyStorage);
+  stopWatching();
+  $("sign-in").hidden = false;
+  $("active").hidden = true;
+  $("history").hidden = true;
+  $("sign-out").hidden = true;
+}
+
+async function start() {
+  showError(null);
+  $("sign-in").hidden = true;
+  $("sign-out").hidden = false;
+  try {
+    const res = await call("ListQueues");
+    const select = $("queue");
+    select.replaceChildren();
+    for (const q of res.queues) {
+      select.append(new Option(q.paused ? q.name + " (paused)" : q.name, q.id.id));
+    }
+    const saved = localStorage.getItem(queueStorage);
+    if (saved && res.queues.some((q) => q.id.id === saved)) {
+      select.value = saved;
+    }
+    show(view);
+  } catch (err) {
+    showError(err);
+  }
+}
+
+// Views
+
+function show(name) {
+  view = name;
+  for (const button of document.querySelectorAll("nav button")) {
+    button.classList.toggle("selected", button.dataset.view === name);
+  }
+  $("active").hidden = name !== "active";
+  $("history").hidden = name !== "history";
+  stopWatching();
+  if (name === "active") {
+    startWatching();
+  } else {
+    loadHistory(true);
+  }
+}
+
+// The active queue is kept up to date by watching the queue's events.  items holds the items in
+// the order they are to be downloaded.
+
+let items = [];
+
+function stopWatching() {
+  if (watch) {
+    watch.abort();
+    watch = null;
+  }
+}
+
+function startWatching() {
+  const controller = new AbortController();
+  watch = controller;
+  let sequence = 0;
+  let snapshot = null;
+
+  const connect = async () => {
+    $("connection").textContent = "Connecting…";
+    snapshot = null;
+    try {
+      await stream("WatchQueue", {queue: {id: currentQueue()}, resumeFrom: String(sequence)}, controller.signal, (event) => {
+        sequence = event.sequence;
+        switch (event.type) {
+          case "QUEUE_EVENT_TYPE_SNAPSHOT":
+            snapshot = snapshot || [];
+            snapshot.push(event.item);
+            break;
+          case "QUEUE_EVENT_TYPE_SNAPSHOT_COMPLETE":
+            items = snapshot || [];
+            snapshot = null;
+            $("connection").textContent = "";
+            renderItems();
+            break;
+          default:
+            $("connection").textContent = "";
+            applyEvent(event);
+        }
+      });
+    } catch (err) {
+      if (err instanceof Unauthenticated || err.name === "AbortError") {
+        showError(err);
+        return;
+      }
+      $("connection").textContent = "Disconnected: " + err.message + ". Reconnecting…";
+    }
+    if (!controller.signal.aborted) {
+      setTimeout(connect, reconnectDelayMs);
+    }
+  };
+  connect();
+}
+
+function applyEvent(event) {
+  const id = event.item.id.id;
+  const index = items.findIndex((item) => item.id.id === id);
+  switch (event.type) {
+    case "QUEUE_EVENT_TYPE_ENQUEUED":
+      if (index < 0) {
+        items.push(event.item);
+      }
+      break;
+    case "QUEUE_EVENT_TYPE_CLAIMED":
+    case "QUEUE_EVENT_TYPE_UPDATED":
+      if (index >= 0) {
+        // updates carry only the id and state, so keep the rest of the item
+        items[index] = {...items[index], ...withoutEmpty(event.item)};
+      } else if (event.item.item) {
+        items.push(event.item);
+      }
+      break;
+    case "QUEUE_EVENT_TYPE_CANCELLED":
+    case "QUEUE_EVENT_TYPE_FINISHED":
+      if (index >= 0) {
+        items.splice(index, 1);
+      }
+      break;
+    case "QUEUE_EVENT_TYPE_MOVED":
+      reloadOrder();
+      return;
+  }
+  renderItems();
+}
+
+// withoutEmpty drops the fields of an event's item which weren't populated.
+function withoutEmpty(item) {
+  const out = {};
+  for (const [k, v] of Object.entries(item)) {
+    if (v !== null && !(Array.isArray(v) && v.length === 0) && v !== "") {
+      out[k] = v;
+    }
+  }
+  return out;
+}
+
+async function reloadOrder() {
+  try {
+    const res = await call("GetQueueItems", {queue: {id: currentQueue()}});
+    items = res.items;
+    renderItems();
+  } catch (err) {
+    showError(err);
+  }
+}
+
+function renderItems() {
+  const template = $("item-row");
+  const rows = items.map((item) => {
+    const row = template.content.firstElementChild.cloneNode(true);
+    const state = item.state || {};
+    row.querySelector(".source").textContent = item.item?.source?.url || "";
+    row.querySelector(".source").title = item.item?.source?.url || "";
+    row.querySelector(".destination").textContent = item.item?.destination?.url || "";
+    row.querySelector(".destination").title = item.item?.destination?.url || "";
+    const stateCell = row.querySelector(".state");
+    stateCell.textContent = stateNames[state.state] || state.state || "";
+    stateCell.className = "state state-" + state.state;
+    if (state.message) {
+      stateCell.title = state.message;
+    }
+
+    const progress = row.querySelector("progress");
+    const total = Number(state.totalSizeBytes || 0);
+    const downloaded = Number(state.downloadedBytes || 0);
+    if (total > 0) {
+      progress.value = downloaded / total;
+      row.querySelector(".bytes").textContent = formatBytes(downloaded) + " / " + formatBytes(total);
+    } else if (state.state === "ITEM_STATE_DOWNLOADING") {
+      progress.removeAttribute("value");
+      row.querySelector(".bytes").textContent = formatBytes(downloaded);
+    }
+
+    row.querySelector(".cancel").addEventListener("click", () => cancel(item.id.id));
+    return row;
+  });
+  $("items").replaceChildren(...rows);
+  $("no-items").hidden = items.length > 0;
+}
+
+async function cancel(id) {
+  try {
+    await call("CancelItem", {item: {id}});
+  } catch (err) {
+    showError(err);
+  }
+}
+
+async function add(form) {
+  const source = form.source.value.trim();
+  let destination = form.destination.value.trim();
+  if (!destination) {
+    destination = decodeURIComponent(new URL(source).pathname.split("/").filter(Boolean).pop() || "download");
+  }
+  if (!destination.includes("://")) {
+    destination = "file://" + destination;
+  }
+  const item = {source: {url: source}, destination: {url: destination}};
+  if (form.category.value.trim()) {
+    item.category = {id: {id: form.category.value.trim()}};
+  }
+  if (form.priority.value) {
+    item.priority = Number(form.priority.value);
+  }
+  try {
+    await call("EnqueueItem", {queue: {id: currentQueue()}, item});
+    form.reset();
+    showError(null);
+  } catch (err) {
+    showError(err);
+  }
+}
+
+// History
+
+async function loadHistory(reset) {
+  const form = $("filter");
+  if (reset) {
+    historyCursor = "";
+    $("finished").replaceChildren();
+  }
+  const filter = {
+    sourceHost: form.sourceHost.value.trim(),
+    category: form.category.value.trim(),
+    destinationPrefix: form.destinationPrefix.value.trim(),
+  };
+  if (form.state.value) {
+    filter.states = [form.state.value];
+  }
+  try {
+    const res = await call("GetFinishedItems", {
+      queue: {id: currentQueue()},
+      pagination: {limit: historyPageSize, next: {id: historyCursor}},
+      filter,
+      sort: {field: "SORT_FIELD_DEFAULT", descending: form.order.value === "desc"},
+    });
+    for (const item of res.items) {
+      $("finished").append(historyRow(item));
+    }
+    historyCursor = res.pagination?.next?.id || "";
+    $("more").hidden = !historyCursor;
+    $("no-finished").hidden = $("finished").children.length > 0;
+    showError(null);
+  } catch (err) {
+    showError(err);
+  }
+}
+
+function historyRow(item) {
+  const state = item.state || {};
+  const row = document.createElement("tr");
+  const cells = [
+    item.updated ? new Date(item.updated).toLocaleString() : "",
+    item.item?.source?.url || "",
+    item.item?.destination?.url || "",
+    stateNames[state.state] || state.state || "",
+    formatBytes(state.totalSizeBytes),
+    String((item.attempts || []).length + 1),
+    state.message || "",
+  ];
+  cells.forEach((text, i) => {
+    const cell = document.createElement("td");
+    cell.textContent = text;
+    cell.title = text;
+    if (i === 1 || i === 2) {
+      cell.className = "url";
+    }
+    if (i === 3) {
+      cell.className = "state-" + state.state;
+    }
+    row.append(cell);
+  });
+  return row;
+}
+
+async function clearHistory() {
+  if (!confirm("Remove every finished item from the history of " + currentQueue() + "?")) {
+    return;
+  }
+  try {
+    await call("ClearHistory", {queue: {id: currentQueue()}});
+    loadHistory(true);
+  } catch (err) {
+    showError(err);
+  }
+}
+
+// Wiring
+
+$("sign-in").addEventListener("submit", (e) => {
+  e.preventDefault();
+  localStorage.setItem(keyStorage, e.target.key.value);
+  e.target.reset();
+  start();
+});
+$("sign-out").addEventListener("click", signOut);
+$("queue").addEventListener("change", () => {
+  localStorage.setItem(queueStorage, currentQueue());
+  show(view);
+});
+for (const button of document.querySelectorAll("nav button")) {
+  button.addEventListener("click", () => show(button.dataset.view));
+}
+$("add").addEventListener("submit", (e) => {
+  e.preventDefault();
+  add(e.target);
+});
+$("filter").addEventListener("submit", (e) => {
+  e.preventDefault();
+  loadHistory(true);
+});
+$("more").addEventListener("click", () => loadHistory(false));
+$("clear-history").addEventListener("click", clearHistory);
+
+if (localStorage.getItem(keyStorage)) {
+  start();
+} else {
+  signOut();
+}
@@ -0,0 +1,23 @@
+// Package web holds the queue service's browser interface.  It is a single page, which calls the
+// service through the HTTP gateway with a key the user enters, so it is served alongside the
+// gateway.
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler returns a handler which serves the interface's files.
+func Handler() http.Handler {
+	files, err := fs.Sub(static, "static")
+	if err != nil {
+		// the directory is embedded, so this can't happen
+		panic(err)
+	}
+	return http.FileServer(http.FS(files))
+}
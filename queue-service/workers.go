@@ -0,0 +1,81 @@
+package queue_service
+
+import (
+	"context"
+	"github.com/harryrose/godm/queue-service/functional"
+	"github.com/harryrose/godm/queue-service/queue"
+	"github.com/harryrose/godm/queue-service/rpc"
+	"github.com/harryrose/godm/queue-service/workers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) RegisterWorker(ctx context.Context, in *rpc.RegisterWorkerInput) (*rpc.RegisterWorkerResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.Name) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be provided and non-empty")
+	}
+	if s.Workers == nil {
+		return nil, status.Errorf(codes.Unimplemented, "worker registration is not enabled")
+	}
+	w, err := s.Workers.Register(workers.Worker{
+		Name:      in.Name,
+		Host:      in.Host,
+		Version:   in.Version,
+		Schemes:   in.Schemes,
+		RateLimit: in.RateLimitBytesPerSecond,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &rpc.RegisterWorkerResult{
+		WorkerId:          w.ID,
+		HeartbeatInterval: durationpb.New(s.Workers.HeartbeatInterval()),
+	}, nil
+}
+
+func (s *Service) Heartbeat(ctx context.Context, in *rpc.HeartbeatInput) (*rpc.HeartbeatResult, error) {
+	if in == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no parameters provided")
+	}
+	if len(in.WorkerId) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "worker id must be provided and non-empty")
+	}
+	if s.Workers == nil {
+		return nil, status.Errorf(codes.Unimplemented, "worker registration is not enabled")
+	}
+	if !s.Workers.Heartbeat(in.WorkerId, in.GetCurrentItem().GetId()) {
+		return nil, status.Errorf(codes.NotFound, "worker %v is not registered", in.WorkerId)
+	}
+	return &rpc.HeartbeatResult{}, nil
+}
+
+func (s *Service) ListWorkers(ctx context.Context, in *rpc.ListWorkersInput) (*rpc.ListWorkersResult, error) {
+	if s.Workers == nil {
+		return nil, status.Errorf(codes.Unimplemented, "worker registration is not enabled")
+	}
+	return &rpc.ListWorkersResult{
+		Workers: functional.Map(s.Workers.List(), workerToRPC),
+	}, nil
+}
+
+func workerToRPC(w workers.Worker) *rpc.Worker {
+	out := &rpc.Worker{
+		Id:                      w.ID,
+		Name:                    w.Name,
+		Host:                    w.Host,
+		Version:                 w.Version,
+		Schemes:                 w.Schemes,
+		RateLimitBytesPerSecond: w.RateLimit,
+		Registered:              timestamppb.New(w.Registered),
+		LastSeen:                timestamppb.New(w.LastSeen),
+	}
+	if w.CurrentItem != "" {
+		out.CurrentItem = &queue.Identifier{Id: w.CurrentItem}
+	}
+	return out
+}
@@ -0,0 +1,110 @@
+package workers
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/harryrose/godm/queue-service/db/cache"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long a worker stays registered without a heartbeat.
+const DefaultTTL = 30 * time.Second
+
+// Worker describes a registered downloader.
+type Worker struct {
+	ID          string
+	Name        string
+	Host        string
+	Version     string
+	Schemes     []string
+	RateLimit   uint64
+	CurrentItem string
+	Registered  time.Time
+	LastSeen    time.Time
+}
+
+// Registry tracks the downloaders which have registered with the service.  Workers which don't
+// send a heartbeat within the registry's TTL expire.  The zero value uses DefaultTTL.
+type Registry struct {
+	TTL time.Duration
+	// Now returns the current time, which workers are registered, seen and expired at.  If nil,
+	// time.Now is used.
+	Now func() time.Time
+
+	once    sync.Once
+	mux     sync.Mutex
+	workers cache.TTL[string, Worker]
+}
+
+func (r *Registry) init() {
+	r.once.Do(func() {
+		r.workers.TTL = r.TTL
+		r.workers.Now = r.Now
+		if r.workers.Now == nil {
+			r.workers.Now = time.Now
+		}
+		if r.workers.TTL <= 0 {
+			r.workers.TTL = DefaultTTL
+		}
+	})
+}
+
+// HeartbeatInterval returns how often workers should send a heartbeat to stay registered.
+func (r *Registry) HeartbeatInterval() time.Duration {
+	r.init()
+	return r.workers.TTL / 3
+}
+
+// Register adds a worker to the registry, assigning it an id derived from its name.
+func (r *Registry) Register(w Worker) (Worker, error) {
+	r.init()
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return Worker{}, fmt.Errorf("error generating worker id: %w", err)
+	}
+	w.ID = fmt.Sprintf("%s-%s", cmp.Or(w.Name, "worker"), hex.EncodeToString(suffix))
+	w.Registered = r.workers.Now()
+	w.LastSeen = w.Registered
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.workers.Set(w.ID, w)
+	return w, nil
+}
+
+// Heartbeat records that the worker is alive and downloading the given item, which is empty if
+// it is idle.  It returns false if the worker isn't registered, or has expired.
+func (r *Registry) Heartbeat(id string, currentItem string) bool {
+	r.init()
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	w, ok := r.workers.Get(id)
+	if !ok {
+		return false
+	}
+	w.CurrentItem = currentItem
+	w.LastSeen = r.workers.Now()
+	r.workers.Set(id, w)
+	return true
+}
+
+// List returns the registered workers, ordered by name.
+func (r *Registry) List() []Worker {
+	r.init()
+	out := r.workers.Values()
+	slices.SortFunc(out, func(a, b Worker) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+	return out
+}
+
+// CleanLoopAsync periodically forgets expired workers until the context is cancelled.
+func (r *Registry) CleanLoopAsync(ctx context.Context) {
+	r.init()
+	r.workers.CleanLoopAsync(ctx, r.workers.TTL)
+}
@@ -0,0 +1,50 @@
+package workers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := &Registry{TTL: 30 * time.Second, Now: func() time.Time { return now }}
+	b, err := r.Register(Worker{Name: "b", Host: "box2"})
+	if err != nil {
+		t.Fatalf("unable to register worker: %v", err)
+	}
+	a, err := r.Register(Worker{Name: "a", Host: "box1"})
+	if err != nil {
+		t.Fatalf("unable to register worker: %v", err)
+	}
+	if !strings.HasPrefix(a.ID, "a-") || a.ID == b.ID {
+		t.Errorf("expected distinct ids derived from the names, got %v and %v", a.ID, b.ID)
+	}
+	if !a.Registered.Equal(now) {
+		t.Errorf("expected a to be registered at %v, got %v", now, a.Registered)
+	}
+
+	now = now.Add(20 * time.Second)
+	if !r.Heartbeat(a.ID, "q:1") {
+		t.Fatalf("expected the heartbeat to be accepted")
+	}
+	listed := r.List()
+	if len(listed) != 2 || listed[0].ID != a.ID || listed[0].CurrentItem != "q:1" || !listed[0].LastSeen.Equal(now) {
+		t.Fatalf("expected both workers, a downloading q:1 and last seen at %v, got %v", now, listed)
+	}
+
+	// b hasn't sent a heartbeat, so expires first
+	now = now.Add(10 * time.Second)
+	listed = r.List()
+	if len(listed) != 1 || listed[0].ID != a.ID {
+		t.Errorf("expected only a to remain, got %v", listed)
+	}
+	if r.Heartbeat(b.ID, "") {
+		t.Errorf("expected the expired worker's heartbeat to be rejected")
+	}
+
+	now = now.Add(20 * time.Second)
+	if listed := r.List(); len(listed) != 0 {
+		t.Errorf("expected a to expire a TTL after its heartbeat, got %v", listed)
+	}
+}